@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/spf13/cobra"
@@ -14,7 +15,12 @@ var (
 	moduleName string
 	framework  string
 	database   string
+	loggerLib  string
+	dataAccess string
+	cacheStore string
 	enableGRPC bool
+	enableAuth bool
+	enableOTel bool
 )
 
 var initCmd = &cobra.Command{
@@ -28,8 +34,13 @@ var initCmd = &cobra.Command{
 func init() {
 	initCmd.Flags().StringVar(&moduleName, "module", "", "Go module name (например: github.com/yourorg/project)")
 	initCmd.Flags().StringVar(&framework, "framework", "", "Веб-фреймворк (gin, fiber, echo)")
-	initCmd.Flags().StringVar(&database, "database", "", "База данных (postgresql, mysql, mongodb, in-memory, none)")
+	initCmd.Flags().StringVar(&database, "database", "", "База данных (postgresql, mysql, mongodb, cockroachdb, clickhouse, sqlite, in-memory, none)")
+	initCmd.Flags().StringVar(&loggerLib, "logger", "logrus", "Логгер (logrus, slog, zap, zerolog)")
+	initCmd.Flags().StringVar(&dataAccess, "data-access", "gorm", "Доступ к SQL базе (gorm, sqlx, pgx, sqlc)")
+	initCmd.Flags().StringVar(&cacheStore, "cache", "none", "Кэш поверх основной БД (none, redis)")
 	initCmd.Flags().BoolVar(&enableGRPC, "grpc", false, "Включить gRPC сервер")
+	initCmd.Flags().BoolVar(&enableAuth, "auth", false, "Включить JWT/OIDC аутентификацию")
+	initCmd.Flags().BoolVar(&enableOTel, "otel", false, "Включить трассировку OpenTelemetry")
 }
 
 func runInit(cmd *cobra.Command, args []string) error {
@@ -81,7 +92,7 @@ func runInit(cmd *cobra.Command, args []string) error {
 	} else {
 		dbPrompt := &survey.Select{
 			Message: "Выберите базу данных:",
-			Options: []string{"PostgreSQL", "MySQL", "MongoDB", "In-Memory", "Без БД"},
+			Options: []string{"PostgreSQL", "MySQL", "MongoDB", "CockroachDB", "ClickHouse", "SQLite", "In-Memory", "Без БД"},
 			Default: "PostgreSQL",
 		}
 		if err := survey.AskOne(dbPrompt, &config.Database); err != nil {
@@ -89,6 +100,37 @@ func runInit(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	// Логгер
+	switch strings.ToLower(loggerLib) {
+	case "logrus", "slog", "zap", "zerolog":
+		config.Logger = strings.ToLower(loggerLib)
+	default:
+		return fmt.Errorf("неизвестный логгер %q: доступны logrus, slog, zap, zerolog", loggerLib)
+	}
+
+	// Слой доступа к данным
+	switch strings.ToLower(dataAccess) {
+	case "gorm", "sqlx", "sqlc":
+		config.DataAccess = strings.ToLower(dataAccess)
+	case "pgx":
+		if db := strings.ToLower(config.Database); db != "postgresql" && db != "cockroachdb" {
+			return fmt.Errorf("--data-access pgx поддерживается только для PostgreSQL и CockroachDB")
+		}
+		config.DataAccess = "pgx"
+	default:
+		return fmt.Errorf("неизвестный слой доступа к данным %q: доступны gorm, sqlx, pgx, sqlc", dataAccess)
+	}
+
+	// Кэш
+	switch strings.ToLower(cacheStore) {
+	case "none", "":
+		config.Cache = ""
+	case "redis":
+		config.Cache = "redis"
+	default:
+		return fmt.Errorf("неизвестный кэш %q: доступны none, redis", cacheStore)
+	}
+
 	// gRPC
 	if cmd.Flags().Changed("grpc") {
 		config.EnableGRPC = enableGRPC
@@ -102,6 +144,10 @@ func runInit(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	// Аутентификация и трассировка включаются только флагами
+	config.EnableAuth = enableAuth
+	config.EnableOTel = enableOTel
+
 	// Путь для создания проекта
 	currentDir, err := os.Getwd()
 	if err != nil {
@@ -113,7 +159,16 @@ func runInit(cmd *cobra.Command, args []string) error {
 	fmt.Printf("\n🚀 Создание проекта '%s' в %s\n", config.Name, config.Path)
 	fmt.Printf("📦 Framework: %s\n", config.Framework)
 	fmt.Printf("🗄️  Database: %s\n", config.Database)
+	fmt.Printf("📝 Logger: %s\n", config.Logger)
+	if config.DataAccess != "gorm" {
+		fmt.Printf("🧩 Data access: %s\n", config.DataAccess)
+	}
+	if config.Cache != "" {
+		fmt.Printf("⚡ Cache: %s\n", config.Cache)
+	}
 	fmt.Printf("🌐 gRPC: %t\n", config.EnableGRPC)
+	fmt.Printf("🔐 Auth: %t\n", config.EnableAuth)
+	fmt.Printf("🔭 OpenTelemetry: %t\n", config.EnableOTel)
 
 	generator := generator.New(config.Path)
 	if err := generator.Generate(config); err != nil {
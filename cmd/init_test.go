@@ -40,6 +40,27 @@ func TestProjectStructure(t *testing.T) {
 		"../internal/generator/docker.go",
 		"../internal/generator/makefile.go",
 		"../internal/generator/grpc.go",
+		"../internal/generator/middleware.go",
+		"../internal/generator/tls.go",
+		"../internal/generator/auth.go",
+		"../internal/generator/ratelimit.go",
+		"../internal/generator/requestid.go",
+		"../internal/generator/tracing.go",
+		"../internal/generator/metrics.go",
+		"../internal/generator/health.go",
+		"../internal/generator/logger.go",
+		"../internal/generator/admin.go",
+		"../internal/generator/validation.go",
+		"../internal/generator/secrets.go",
+		"../internal/generator/reload.go",
+		"../internal/generator/migrations.go",
+		"../internal/generator/dataaccess.go",
+		"../internal/generator/repository.go",
+		"../internal/generator/transaction.go",
+		"../internal/generator/cache.go",
+		"../internal/generator/replicas.go",
+		"../internal/generator/cockroach.go",
+		"../internal/generator/clickhouse.go",
 	}
 
 	for _, file := range requiredFiles {
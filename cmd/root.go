@@ -13,7 +13,7 @@ var rootCmd = &cobra.Command{
 Аналогично django-admin startproject, но для Go микросервисов.
 
 Поддерживает:
-- Выбор БД (PostgreSQL, MySQL, MongoDB, in-memory, без БД)
+- Выбор БД (PostgreSQL, MySQL, MongoDB, CockroachDB, ClickHouse, SQLite, in-memory, без БД)
 - Выбор фреймворка (Fiber, Gin, Echo)
 - Опциональный gRPC сервер
 - Автогенерация Swagger, Dockerfile, Makefile
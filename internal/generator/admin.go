@@ -0,0 +1,206 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// generateAdmin создает internal/admin: отдельный HTTP сервер служебных эндпоинтов
+func (g *Generator) generateAdmin(config *ProjectConfig) error {
+	adminDir := filepath.Join(g.projectPath, "internal/admin")
+	if err := os.MkdirAll(adminDir, 0755); err != nil {
+		return err
+	}
+
+	files := map[string]string{
+		"admin.go":      g.generateAdminServer(config),
+		"admin_test.go": g.generateAdminTest(config),
+	}
+
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(adminDir, name), []byte(content), 0644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// generateAdminServer генерирует admin сервер: pprof, expvar, сборка, конфигурация и уровни логирования
+func (g *Generator) generateAdminServer(config *ProjectConfig) string {
+	return `package admin
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"expvar"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"time"
+
+	"` + config.ModuleName + `/internal/config"
+	"` + config.ModuleName + `/pkg/logger"
+)
+
+// BuildInfo версия сборки. Version и Commit задаются через -ldflags "-X main.version=... -X main.commit=..."
+type BuildInfo struct {
+	Version   string ` + "`" + `json:"version"` + "`" + `
+	Commit    string ` + "`" + `json:"commit"` + "`" + `
+	BuildTime string ` + "`" + `json:"build_time,omitempty"` + "`" + `
+	GoVersion string ` + "`" + `json:"go_version"` + "`" + `
+	Module    string ` + "`" + `json:"module,omitempty"` + "`" + `
+}
+
+// New создает admin сервер на admin.host:admin.port: pprof, expvar, /buildinfo, /config и /log/level.
+// Все эндпоинты требуют токен admin.token, если он задан. Без токена сервис не проходит валидацию
+// конфигурации, если admin.host не loopback адрес и app.debug выключен
+func New(cfg *config.Config, info BuildInfo, levels *logger.Levels) *http.Server {
+	mux := http.NewServeMux()
+
+	// Профилирование
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+
+	// Счетчики expvar (memstats, cmdline и собственные переменные сервиса)
+	mux.Handle("/debug/vars", expvar.Handler())
+
+	mux.Handle("/buildinfo", jsonHandler(func() (interface{}, error) {
+		return completeBuildInfo(info), nil
+	}))
+	mux.Handle("/config", jsonHandler(func() (interface{}, error) {
+		return cfg.Redacted()
+	}))
+	mux.Handle("/log/level", levels.Handler())
+
+	return &http.Server{
+		Addr:              net.JoinHostPort(cfg.Admin.Host, strconv.Itoa(cfg.Admin.Port)),
+		Handler:           requireToken(cfg.Admin.Token, mux),
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+}
+
+// requireToken проверяет заголовок Authorization: Bearer <token>. Пустой token отключает проверку,
+// это допускается только для loopback адреса или в режиме отладки (см. validateObservability)
+func requireToken(token string, next http.Handler) http.Handler {
+	if token == "" {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		provided := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(provided), []byte(token)) != 1 {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// jsonHandler отдает результат load в JSON
+func jsonHandler(load func() (interface{}, error)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", "GET")
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		value, err := load()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		_ = enc.Encode(value)
+	})
+}
+
+// completeBuildInfo дополняет info данными из debug.ReadBuildInfo, если версия не задана при сборке
+func completeBuildInfo(info BuildInfo) BuildInfo {
+	info.GoVersion = runtime.Version()
+
+	build, ok := debug.ReadBuildInfo()
+	if !ok {
+		return info
+	}
+
+	info.Module = build.Main.Path
+	for _, setting := range build.Settings {
+		switch setting.Key {
+		case "vcs.revision":
+			if info.Commit == "" || info.Commit == "none" {
+				info.Commit = setting.Value
+			}
+		case "vcs.time":
+			if info.BuildTime == "" {
+				info.BuildTime = setting.Value
+			}
+		}
+	}
+
+	return info
+}
+`
+}
+
+// generateAdminTest генерирует тесты admin сервера
+func (g *Generator) generateAdminTest(config *ProjectConfig) string {
+	return `package admin
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"` + config.ModuleName + `/internal/config"
+	"` + config.ModuleName + `/pkg/logger"
+)
+
+func newTestServer(token string) http.Handler {
+	cfg := &config.Config{Admin: config.AdminConfig{Enabled: true, Port: 9091, Token: token}}
+	cfg.Database.Password = "db-password"
+	return New(cfg, BuildInfo{Version: "1.2.3"}, logger.NewLevels("info", nil)).Handler
+}
+
+func TestTokenRequired(t *testing.T) {
+	handler := newTestServer("admin-token")
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/buildinfo", nil))
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401 without token, got %d", rec.Code)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/buildinfo", nil)
+	req.Header.Set("Authorization", "Bearer admin-token")
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), ` + "`" + `"version": "1.2.3"` + "`" + `) {
+		t.Errorf("unexpected build info response: %d %s", rec.Code, rec.Body.String())
+	}
+}
+
+func TestConfigIsRedacted(t *testing.T) {
+	rec := httptest.NewRecorder()
+	newTestServer("").ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/config", nil))
+
+	body := rec.Body.String()
+	if rec.Code != http.StatusOK || strings.Contains(body, "db-password") {
+		t.Errorf("config must be returned without secrets: %d %s", rec.Code, body)
+	}
+}
+`
+}
@@ -0,0 +1,1227 @@
+package generator
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// generateAuth создает модуль аутентификации pkg/auth и middleware для него
+func (g *Generator) generateAuth(config *ProjectConfig) error {
+	authDir := filepath.Join(g.projectPath, "pkg/auth")
+	if err := os.MkdirAll(authDir, 0755); err != nil {
+		return err
+	}
+
+	files := map[string]string{
+		"auth.go":      g.generateAuthVerifier(config),
+		"jwks.go":      g.generateAuthJWKS(),
+		"auth_test.go": g.generateAuthTest(config),
+	}
+
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(authDir, name), []byte(content), 0644); err != nil {
+			return err
+		}
+	}
+
+	// Создаем middleware аутентификации
+	if err := g.generateAuthMiddleware(config); err != nil {
+		return err
+	}
+
+	// Создаем защищенный handler
+	return g.generateAuthHandler(config)
+}
+
+// generateAuthVerifier генерирует проверку JWT токенов
+func (g *Generator) generateAuthVerifier(config *ProjectConfig) string {
+	return fmt.Sprintf(`package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"%s/internal/config"
+)
+
+var (
+	// ErrMissingToken токен не передан
+	ErrMissingToken = errors.New("токен авторизации не передан")
+	// ErrInvalidToken токен невалиден или просрочен
+	ErrInvalidToken = errors.New("невалидный токен")
+	// ErrInsufficientScope у токена нет необходимых scopes
+	ErrInsufficientScope = errors.New("недостаточно прав")
+)
+
+// Claims данные, извлеченные из проверенного токена
+type Claims struct {
+	Subject   string
+	Issuer    string
+	Audience  []string
+	ExpiresAt time.Time
+	Scopes    []string
+	Roles     []string
+	Raw       jwt.MapClaims
+}
+
+// HasScopes проверяет, что токен содержит все указанные scopes
+func (c *Claims) HasScopes(required ...string) bool {
+	granted := make(map[string]struct{}, len(c.Scopes))
+	for _, scope := range c.Scopes {
+		granted[scope] = struct{}{}
+	}
+
+	for _, scope := range required {
+		if _, ok := granted[scope]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Verifier проверяет JWT токены по HMAC секрету или JWKS
+type Verifier struct {
+	cfg    config.AuthConfig
+	parser *jwt.Parser
+	jwks   *JWKS
+}
+
+// NewVerifier создает Verifier по конфигурации
+func NewVerifier(cfg config.AuthConfig) (*Verifier, error) {
+	v := &Verifier{cfg: cfg}
+
+	var methods []string
+	switch {
+	case cfg.JWKSURL != "":
+		v.jwks = NewJWKS(cfg.JWKSURL, time.Duration(cfg.JWKSCacheTTL)*time.Second)
+		methods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+	case cfg.Secret != "":
+		methods = []string{"HS256", "HS384", "HS512"}
+	default:
+		return nil, errors.New("необходимо указать auth.secret или auth.jwks_url")
+	}
+
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods(methods),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(time.Duration(cfg.Leeway) * time.Second),
+	}
+	if cfg.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(cfg.Issuer))
+	}
+	if cfg.Audience != "" {
+		opts = append(opts, jwt.WithAudience(cfg.Audience))
+	}
+
+	v.parser = jwt.NewParser(opts...)
+
+	return v, nil
+}
+
+// Verify проверяет токен и возвращает его claims
+func (v *Verifier) Verify(ctx context.Context, tokenString string) (*Claims, error) {
+	if tokenString == "" {
+		return nil, ErrMissingToken
+	}
+
+	mapClaims := jwt.MapClaims{}
+	_, err := v.parser.ParseWithClaims(tokenString, mapClaims, func(token *jwt.Token) (interface{}, error) {
+		if v.jwks == nil {
+			return []byte(v.cfg.Secret), nil
+		}
+		kid, _ := token.Header["kid"].(string)
+		return v.jwks.Key(ctx, kid)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%%w: %%v", ErrInvalidToken, err)
+	}
+
+	claims := &Claims{
+		Scopes: scopesFromClaims(mapClaims),
+		Roles:  stringsFromClaim(mapClaims[v.rolesClaim()]),
+		Raw:    mapClaims,
+	}
+	claims.Subject, _ = mapClaims.GetSubject()
+	claims.Issuer, _ = mapClaims.GetIssuer()
+	claims.Audience, _ = mapClaims.GetAudience()
+	if exp, _ := mapClaims.GetExpirationTime(); exp != nil {
+		claims.ExpiresAt = exp.Time
+	}
+
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("%%w: отсутствует sub", ErrInvalidToken)
+	}
+
+	return claims, nil
+}
+
+// rolesClaim возвращает имя claim с ролями
+func (v *Verifier) rolesClaim() string {
+	if v.cfg.RolesClaim != "" {
+		return v.cfg.RolesClaim
+	}
+	return "roles"
+}
+
+// TokenFromHeader извлекает токен из заголовка "Authorization: Bearer <token>"
+func TokenFromHeader(header string) string {
+	const prefix = "bearer "
+	if len(header) < len(prefix) || !strings.EqualFold(header[:len(prefix)], prefix) {
+		return ""
+	}
+	return strings.TrimSpace(header[len(prefix):])
+}
+
+// claimsKey ключ для хранения Claims в context.Context
+type claimsKey struct{}
+
+// WithClaims сохраняет Claims в контексте
+func WithClaims(ctx context.Context, claims *Claims) context.Context {
+	return context.WithValue(ctx, claimsKey{}, claims)
+}
+
+// ClaimsFromContext извлекает Claims из контекста
+func ClaimsFromContext(ctx context.Context) (*Claims, bool) {
+	claims, ok := ctx.Value(claimsKey{}).(*Claims)
+	return claims, ok
+}
+
+// scopesFromClaims поддерживает "scope" (строка через пробел) и "scp" (массив)
+func scopesFromClaims(claims jwt.MapClaims) []string {
+	if scope, ok := claims["scope"].(string); ok {
+		return strings.Fields(scope)
+	}
+	return stringsFromClaim(claims["scp"])
+}
+
+// stringsFromClaim приводит claim к []string
+func stringsFromClaim(value interface{}) []string {
+	switch v := value.(type) {
+	case string:
+		return strings.Fields(v)
+	case []interface{}:
+		result := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				result = append(result, s)
+			}
+		}
+		return result
+	default:
+		return nil
+	}
+}
+`, config.ModuleName)
+}
+
+// generateAuthJWKS генерирует загрузку и кэширование JWKS
+func (g *Generator) generateAuthJWKS() string {
+	return `package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// minRefreshInterval минимальный интервал между загрузками JWKS, в том числе неудачными.
+// Защищает JWKS endpoint от запросов с неизвестными kid и повторов при недоступном провайдере
+const minRefreshInterval = 10 * time.Second
+
+// JWKS кэширует публичные ключи OIDC провайдера и обновляет их при ротации.
+// Ключи загружаются без блокировки кэша: пока идет загрузка, запросы получают ключи из кэша
+type JWKS struct {
+	url    string
+	ttl    time.Duration
+	client *http.Client
+
+	mu          sync.RWMutex
+	keys        map[string]interface{}
+	fetchedAt   time.Time
+	lastAttempt time.Time
+	// lastErr ошибка последней загрузки
+	lastErr error
+	// refreshing закрывается по окончании текущей загрузки, nil - загрузка не идет
+	refreshing chan struct{}
+}
+
+// jsonWebKey ключ в формате JWK
+type jsonWebKey struct {
+	Kty string ` + "`" + `json:"kty"` + "`" + `
+	Kid string ` + "`" + `json:"kid"` + "`" + `
+	Use string ` + "`" + `json:"use"` + "`" + `
+	N   string ` + "`" + `json:"n"` + "`" + `
+	E   string ` + "`" + `json:"e"` + "`" + `
+	Crv string ` + "`" + `json:"crv"` + "`" + `
+	X   string ` + "`" + `json:"x"` + "`" + `
+	Y   string ` + "`" + `json:"y"` + "`" + `
+}
+
+// NewJWKS создает кэш JWKS
+func NewJWKS(url string, ttl time.Duration) *JWKS {
+	if ttl <= 0 {
+		ttl = 5 * time.Minute
+	}
+
+	return &JWKS{
+		url:    url,
+		ttl:    ttl,
+		client: &http.Client{Timeout: 10 * time.Second},
+		keys:   make(map[string]interface{}),
+	}
+}
+
+// Key возвращает ключ по kid. Устаревший ключ возвращается сразу, а ключи обновляются в фоне.
+// При неизвестном kid Key ждет загрузки ключей, но загружает их не чаще minRefreshInterval
+func (j *JWKS) Key(ctx context.Context, kid string) (interface{}, error) {
+	key, ok, fresh := j.lookup(kid)
+	if ok {
+		if !fresh {
+			j.startRefresh()
+		}
+		return key, nil
+	}
+
+	done := j.startRefresh()
+	if done == nil {
+		return nil, fmt.Errorf("ключ %q не найден в JWKS, ключи загружались менее %s назад", kid, minRefreshInterval)
+	}
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	key, ok, _ = j.lookup(kid)
+	if !ok {
+		j.mu.RLock()
+		err := j.lastErr
+		j.mu.RUnlock()
+		if err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("ключ %q не найден в JWKS", kid)
+	}
+
+	return key, nil
+}
+
+// lookup ищет ключ в кэше
+func (j *JWKS) lookup(kid string) (key interface{}, ok bool, fresh bool) {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+
+	fresh = time.Since(j.fetchedAt) < j.ttl
+
+	// Токен без kid допустим, если в наборе ровно один ключ
+	if kid == "" && len(j.keys) == 1 {
+		for _, k := range j.keys {
+			return k, true, fresh
+		}
+	}
+
+	key, ok = j.keys[kid]
+	return key, ok, fresh
+}
+
+// startRefresh запускает загрузку ключей, если она еще не идет и с прошлой попытки прошло не меньше
+// minRefreshInterval. Возвращает канал, который закрывается по окончании загрузки, или nil
+func (j *JWKS) startRefresh() <-chan struct{} {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.refreshing != nil {
+		return j.refreshing
+	}
+	if time.Since(j.lastAttempt) < minRefreshInterval {
+		return nil
+	}
+
+	j.lastAttempt = time.Now()
+	j.refreshing = make(chan struct{})
+	go j.refresh(j.refreshing)
+
+	return j.refreshing
+}
+
+// refresh загружает ключи и закрывает done. Загрузка общая для всех ожидающих запросов,
+// поэтому не зависит от их контекстов и ограничена таймаутом HTTP клиента
+func (j *JWKS) refresh(done chan struct{}) {
+	keys, err := j.fetch(context.Background())
+
+	j.mu.Lock()
+	if err == nil {
+		j.keys = keys
+		j.fetchedAt = time.Now()
+	}
+	j.lastErr = err
+	j.refreshing = nil
+	j.mu.Unlock()
+
+	close(done)
+}
+
+// fetch загружает и разбирает JWKS
+func (j *JWKS) fetch(ctx context.Context) (map[string]interface{}, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, j.url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := j.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка загрузки JWKS: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("JWKS вернул статус %d", resp.StatusCode)
+	}
+
+	var set struct {
+		Keys []jsonWebKey ` + "`" + `json:"keys"` + "`" + `
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&set); err != nil {
+		return nil, fmt.Errorf("ошибка разбора JWKS: %w", err)
+	}
+
+	keys := make(map[string]interface{}, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("JWKS не содержит ключей для подписи")
+	}
+
+	return keys, nil
+}
+
+// publicKey преобразует JWK в публичный ключ
+func (k jsonWebKey) publicKey() (interface{}, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("неподдерживаемая кривая %s", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, fmt.Errorf("неподдерживаемый тип ключа %s", k.Kty)
+	}
+}
+
+// decodeBigInt декодирует base64url число
+func decodeBigInt(value string) (*big.Int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(data), nil
+}
+`
+}
+
+// generateAuthTest генерирует тесты модуля аутентификации
+func (g *Generator) generateAuthTest(config *ProjectConfig) string {
+	return fmt.Sprintf(`package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"%s/internal/config"
+)
+
+// jwksServer in-process JWKS endpoint с возможностью ротации ключей, сбоев и медленных ответов
+type jwksServer struct {
+	mu       sync.Mutex
+	keys     map[string]*rsa.PrivateKey
+	requests int
+	// fail отвечать 503
+	fail bool
+	// block задерживает ответы, пока канал не закрыт
+	block chan struct{}
+}
+
+func (s *jwksServer) setKey(kid string, key *rsa.PrivateKey) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys = map[string]*rsa.PrivateKey{kid: key}
+}
+
+func (s *jwksServer) requestCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests
+}
+
+func (s *jwksServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests++
+	block, fail := s.block, s.fail
+	s.mu.Unlock()
+
+	if block != nil {
+		<-block
+	}
+	if fail {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	keys := make([]map[string]string, 0, len(s.keys))
+	for kid, key := range s.keys {
+		keys = append(keys, map[string]string{
+			"kty": "RSA",
+			"kid": kid,
+			"use": "sig",
+			"alg": "RS256",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"keys": keys})
+}
+
+func generateKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %%v", err)
+	}
+	return key
+}
+
+func signRS256(t *testing.T, key *rsa.PrivateKey, kid string, claims jwt.MapClaims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("Failed to sign token: %%v", err)
+	}
+	return signed
+}
+
+func validClaims() jwt.MapClaims {
+	return jwt.MapClaims{
+		"sub":   "user-42",
+		"iss":   "test-issuer",
+		"aud":   "test-api",
+		"exp":   time.Now().Add(time.Hour).Unix(),
+		"scope": "users:read users:write",
+		"roles": []string{"admin"},
+	}
+}
+
+func TestVerifyHMAC(t *testing.T) {
+	verifier, err := NewVerifier(config.AuthConfig{Secret: "test-secret", Issuer: "test-issuer"})
+	if err != nil {
+		t.Fatalf("Failed to create verifier: %%v", err)
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, validClaims()).SignedString([]byte("test-secret"))
+	if err != nil {
+		t.Fatalf("Failed to sign token: %%v", err)
+	}
+
+	claims, err := verifier.Verify(context.Background(), token)
+	if err != nil {
+		t.Fatalf("Expected valid token, got %%v", err)
+	}
+
+	if claims.Subject != "user-42" {
+		t.Errorf("Expected subject user-42, got %%s", claims.Subject)
+	}
+	if !claims.HasScopes("users:read", "users:write") {
+		t.Errorf("Expected scopes users:read and users:write, got %%v", claims.Scopes)
+	}
+	if claims.HasScopes("users:delete") {
+		t.Error("Unexpected scope users:delete")
+	}
+	if len(claims.Roles) != 1 || claims.Roles[0] != "admin" {
+		t.Errorf("Expected roles [admin], got %%v", claims.Roles)
+	}
+}
+
+func TestVerifyHMACWrongSecret(t *testing.T) {
+	verifier, err := NewVerifier(config.AuthConfig{Secret: "test-secret"})
+	if err != nil {
+		t.Fatalf("Failed to create verifier: %%v", err)
+	}
+
+	token, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, validClaims()).SignedString([]byte("other-secret"))
+
+	if _, err := verifier.Verify(context.Background(), token); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("Expected ErrInvalidToken, got %%v", err)
+	}
+}
+
+func TestVerifyJWKS(t *testing.T) {
+	key := generateKey(t)
+	jwks := &jwksServer{}
+	jwks.setKey("key-1", key)
+
+	server := httptest.NewServer(jwks)
+	defer server.Close()
+
+	verifier, err := NewVerifier(config.AuthConfig{
+		JWKSURL:  server.URL,
+		Issuer:   "test-issuer",
+		Audience: "test-api",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create verifier: %%v", err)
+	}
+
+	claims, err := verifier.Verify(context.Background(), signRS256(t, key, "key-1", validClaims()))
+	if err != nil {
+		t.Fatalf("Expected valid token, got %%v", err)
+	}
+	if claims.Subject != "user-42" {
+		t.Errorf("Expected subject user-42, got %%s", claims.Subject)
+	}
+
+	// Токен с чужим audience
+	wrongAudience := validClaims()
+	wrongAudience["aud"] = "other-api"
+	if _, err := verifier.Verify(context.Background(), signRS256(t, key, "key-1", wrongAudience)); err == nil {
+		t.Error("Expected error for wrong audience")
+	}
+
+	// Просроченный токен
+	expired := validClaims()
+	expired["exp"] = time.Now().Add(-time.Hour).Unix()
+	if _, err := verifier.Verify(context.Background(), signRS256(t, key, "key-1", expired)); err == nil {
+		t.Error("Expected error for expired token")
+	}
+}
+
+func TestJWKSKeyRotation(t *testing.T) {
+	oldKey := generateKey(t)
+	newKey := generateKey(t)
+
+	jwks := &jwksServer{}
+	jwks.setKey("old", oldKey)
+
+	server := httptest.NewServer(jwks)
+	defer server.Close()
+
+	verifier, err := NewVerifier(config.AuthConfig{JWKSURL: server.URL, JWKSCacheTTL: 3600})
+	if err != nil {
+		t.Fatalf("Failed to create verifier: %%v", err)
+	}
+
+	if _, err := verifier.Verify(context.Background(), signRS256(t, oldKey, "old", validClaims())); err != nil {
+		t.Fatalf("Expected valid token before rotation, got %%v", err)
+	}
+
+	// Провайдер ротирует ключ: новый kid должен подхватиться без перезапуска
+	jwks.setKey("new", newKey)
+	verifier.jwks.lastAttempt = time.Time{}
+
+	if _, err := verifier.Verify(context.Background(), signRS256(t, newKey, "new", validClaims())); err != nil {
+		t.Fatalf("Expected valid token after rotation, got %%v", err)
+	}
+}
+
+func TestJWKSServesCachedKeyDuringSlowRefresh(t *testing.T) {
+	key := generateKey(t)
+	jwks := &jwksServer{}
+	jwks.setKey("key-1", key)
+
+	server := httptest.NewServer(jwks)
+	defer server.Close()
+
+	cache := NewJWKS(server.URL, time.Minute)
+	if _, err := cache.Key(context.Background(), "key-1"); err != nil {
+		t.Fatalf("Failed to load key: %%v", err)
+	}
+
+	// Кэш устарел, а провайдер не отвечает: ключ из кэша возвращается без ожидания загрузки
+	block := make(chan struct{})
+	defer close(block)
+	jwks.mu.Lock()
+	jwks.block = block
+	jwks.mu.Unlock()
+
+	cache.mu.Lock()
+	cache.fetchedAt, cache.lastAttempt = time.Time{}, time.Time{}
+	cache.mu.Unlock()
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if _, err := cache.Key(context.Background(), "key-1"); err != nil {
+			t.Fatalf("Expected cached key, got %%v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Cached key waited for the refresh: %%v", elapsed)
+	}
+}
+
+func TestJWKSThrottlesFailedRefresh(t *testing.T) {
+	jwks := &jwksServer{fail: true}
+	server := httptest.NewServer(jwks)
+	defer server.Close()
+
+	cache := NewJWKS(server.URL, time.Minute)
+	for i := 0; i < 3; i++ {
+		if _, err := cache.Key(context.Background(), "key-1"); err == nil {
+			t.Fatal("Expected error while JWKS is unavailable")
+		}
+	}
+	if got := jwks.requestCount(); got != 1 {
+		t.Errorf("Expected one request within minRefreshInterval, got %%d", got)
+	}
+}
+
+func TestJWKSConcurrentRequestsShareRefresh(t *testing.T) {
+	jwks := &jwksServer{}
+	jwks.setKey("key-1", generateKey(t))
+
+	server := httptest.NewServer(jwks)
+	defer server.Close()
+
+	cache := NewJWKS(server.URL, time.Minute)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := cache.Key(context.Background(), "key-1")
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("Expected key, got %%v", err)
+		}
+	}
+	if got := jwks.requestCount(); got != 1 {
+		t.Errorf("Expected a single JWKS request, got %%d", got)
+	}
+}
+
+func TestNewVerifierRequiresKeySource(t *testing.T) {
+	if _, err := NewVerifier(config.AuthConfig{}); err == nil {
+		t.Error("Expected error without secret and jwks_url")
+	}
+}
+
+func TestTokenFromHeader(t *testing.T) {
+	tests := map[string]string{
+		"Bearer abc.def.ghi": "abc.def.ghi",
+		"bearer abc":         "abc",
+		"Basic abc":          "",
+		"":                   "",
+	}
+
+	for header, expected := range tests {
+		if got := TokenFromHeader(header); got != expected {
+			t.Errorf("TokenFromHeader(%%q) = %%q, expected %%q", header, got, expected)
+		}
+	}
+}
+`, config.ModuleName)
+}
+
+// generateAuthMiddleware создает internal/middleware/auth.go для выбранного фреймворка
+func (g *Generator) generateAuthMiddleware(config *ProjectConfig) error {
+	var content string
+
+	switch strings.ToLower(config.Framework) {
+	case "fiber":
+		content = fmt.Sprintf(`package middleware
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+
+	"%s/pkg/auth"
+	appcontext "%s/pkg/context"
+	"%s/pkg/logger"
+)
+
+// AuthMiddleware проверяет Bearer токен и сохраняет пользователя в AppContext
+func AuthMiddleware(verifier *auth.Verifier, log logger.Logger) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		// Токен уже проверен OptionalAuthMiddleware группы маршрутов
+		if _, ok := auth.ClaimsFromContext(c.UserContext()); ok {
+			return c.Next()
+		}
+
+		token := auth.TokenFromHeader(c.Get(fiber.HeaderAuthorization))
+
+		claims, err := verifier.Verify(c.UserContext(), token)
+		if err != nil {
+			log.WithContext(c.UserContext()).Debug("Отказ в аутентификации", "path", c.Path(), "error", err)
+			c.Set(fiber.HeaderWWWAuthenticate, "Bearer")
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": authErrorMessage(err)})
+		}
+
+		c.SetUserContext(withIdentity(c.UserContext(), log, claims))
+		return c.Next()
+	}
+}
+
+// OptionalAuthMiddleware сохраняет пользователя в AppContext, если запрос содержит действительный токен.
+// Запросы без токена или с недействительным токеном проходят дальше анонимно, их отклоняет AuthMiddleware
+func OptionalAuthMiddleware(verifier *auth.Verifier, log logger.Logger) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		token := auth.TokenFromHeader(c.Get(fiber.HeaderAuthorization))
+		if token == "" {
+			return c.Next()
+		}
+
+		if claims, err := verifier.Verify(c.UserContext(), token); err == nil {
+			c.SetUserContext(withIdentity(c.UserContext(), log, claims))
+		}
+		return c.Next()
+	}
+}
+
+// RequireScopes проверяет, что токен содержит все указанные scopes
+func RequireScopes(scopes ...string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		claims, ok := auth.ClaimsFromContext(c.UserContext())
+		if !ok {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": auth.ErrMissingToken.Error()})
+		}
+		if !claims.HasScopes(scopes...) {
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": auth.ErrInsufficientScope.Error()})
+		}
+		return c.Next()
+	}
+}
+`, config.ModuleName, config.ModuleName, config.ModuleName)
+	case "echo":
+		content = fmt.Sprintf(`package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"%s/pkg/auth"
+	appcontext "%s/pkg/context"
+	"%s/pkg/logger"
+)
+
+// AuthMiddleware проверяет Bearer токен и сохраняет пользователя в AppContext
+func AuthMiddleware(verifier *auth.Verifier, log logger.Logger) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+
+			// Токен уже проверен OptionalAuthMiddleware группы маршрутов
+			if _, ok := auth.ClaimsFromContext(req.Context()); ok {
+				return next(c)
+			}
+
+			token := auth.TokenFromHeader(req.Header.Get(echo.HeaderAuthorization))
+
+			claims, err := verifier.Verify(req.Context(), token)
+			if err != nil {
+				log.WithContext(req.Context()).Debug("Отказ в аутентификации", "path", req.URL.Path, "error", err)
+				c.Response().Header().Set(echo.HeaderWWWAuthenticate, "Bearer")
+				return c.JSON(http.StatusUnauthorized, map[string]string{"error": authErrorMessage(err)})
+			}
+
+			c.SetRequest(req.WithContext(withIdentity(req.Context(), log, claims)))
+			return next(c)
+		}
+	}
+}
+
+// OptionalAuthMiddleware сохраняет пользователя в AppContext, если запрос содержит действительный токен.
+// Запросы без токена или с недействительным токеном проходят дальше анонимно, их отклоняет AuthMiddleware
+func OptionalAuthMiddleware(verifier *auth.Verifier, log logger.Logger) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			token := auth.TokenFromHeader(req.Header.Get(echo.HeaderAuthorization))
+			if token == "" {
+				return next(c)
+			}
+
+			if claims, err := verifier.Verify(req.Context(), token); err == nil {
+				c.SetRequest(req.WithContext(withIdentity(req.Context(), log, claims)))
+			}
+			return next(c)
+		}
+	}
+}
+
+// RequireScopes проверяет, что токен содержит все указанные scopes
+func RequireScopes(scopes ...string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			claims, ok := auth.ClaimsFromContext(c.Request().Context())
+			if !ok {
+				return c.JSON(http.StatusUnauthorized, map[string]string{"error": auth.ErrMissingToken.Error()})
+			}
+			if !claims.HasScopes(scopes...) {
+				return c.JSON(http.StatusForbidden, map[string]string{"error": auth.ErrInsufficientScope.Error()})
+			}
+			return next(c)
+		}
+	}
+}
+`, config.ModuleName, config.ModuleName, config.ModuleName)
+	default:
+		content = fmt.Sprintf(`package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"%s/pkg/auth"
+	appcontext "%s/pkg/context"
+	"%s/pkg/logger"
+)
+
+// AuthMiddleware проверяет Bearer токен и сохраняет пользователя в AppContext
+func AuthMiddleware(verifier *auth.Verifier, log logger.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// Токен уже проверен OptionalAuthMiddleware группы маршрутов
+		if _, ok := auth.ClaimsFromContext(c.Request.Context()); ok {
+			c.Next()
+			return
+		}
+
+		token := auth.TokenFromHeader(c.GetHeader("Authorization"))
+
+		claims, err := verifier.Verify(c.Request.Context(), token)
+		if err != nil {
+			log.WithContext(c.Request.Context()).Debug("Отказ в аутентификации", "path", c.Request.URL.Path, "error", err)
+			c.Header("WWW-Authenticate", "Bearer")
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": authErrorMessage(err)})
+			return
+		}
+
+		c.Request = c.Request.WithContext(withIdentity(c.Request.Context(), log, claims))
+		c.Next()
+	}
+}
+
+// OptionalAuthMiddleware сохраняет пользователя в AppContext, если запрос содержит действительный токен.
+// Запросы без токена или с недействительным токеном проходят дальше анонимно, их отклоняет AuthMiddleware
+func OptionalAuthMiddleware(verifier *auth.Verifier, log logger.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token := auth.TokenFromHeader(c.GetHeader("Authorization"))
+		if token == "" {
+			c.Next()
+			return
+		}
+
+		if claims, err := verifier.Verify(c.Request.Context(), token); err == nil {
+			c.Request = c.Request.WithContext(withIdentity(c.Request.Context(), log, claims))
+		}
+		c.Next()
+	}
+}
+
+// RequireScopes проверяет, что токен содержит все указанные scopes
+func RequireScopes(scopes ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		claims, ok := auth.ClaimsFromContext(c.Request.Context())
+		if !ok {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": auth.ErrMissingToken.Error()})
+			return
+		}
+		if !claims.HasScopes(scopes...) {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": auth.ErrInsufficientScope.Error()})
+			return
+		}
+		c.Next()
+	}
+}
+`, config.ModuleName, config.ModuleName, config.ModuleName)
+	}
+
+	content += `
+// withIdentity сохраняет claims и пользователя в контексте запроса
+func withIdentity(ctx context.Context, log logger.Logger, claims *auth.Claims) context.Context {
+	appCtx, ok := appcontext.FromContext(ctx)
+	if !ok {
+		appCtx = appcontext.New(ctx, log)
+	}
+	appCtx = appCtx.WithUserID(claims.Subject).WithRoles(claims.Roles)
+
+	return appcontext.ToContext(auth.WithClaims(ctx, claims), appCtx)
+}
+
+// authErrorMessage возвращает сообщение об ошибке без деталей проверки
+func authErrorMessage(err error) string {
+	if errors.Is(err, auth.ErrMissingToken) {
+		return auth.ErrMissingToken.Error()
+	}
+	return auth.ErrInvalidToken.Error()
+}
+`
+
+	authPath := filepath.Join(g.projectPath, "internal/middleware/auth.go")
+	return os.WriteFile(authPath, []byte(content), 0644)
+}
+
+// generateAuthHandler создает internal/handlers/auth.go с примером защищенного endpoint
+func (g *Generator) generateAuthHandler(config *ProjectConfig) error {
+	var content string
+
+	switch strings.ToLower(config.Framework) {
+	case "fiber":
+		content = fmt.Sprintf(`package handlers
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	appcontext "%s/pkg/context"
+)
+
+// Me возвращает данные текущего пользователя
+// @Summary Текущий пользователь
+// @Description Возвращает ID и роли пользователя из токена
+// @Tags auth
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} map[string]interface{}
+// @Failure 401 {object} map[string]string
+// @Router /api/v1/me [get]
+func (h *Handler) Me(c *fiber.Ctx) error {
+	appCtx, ok := appcontext.FromContext(c.UserContext())
+	if !ok {
+		return c.SendStatus(fiber.StatusUnauthorized)
+	}
+
+	return c.JSON(fiber.Map{
+		"user_id": appCtx.UserID(),
+		"roles":   appCtx.Roles(),
+	})
+}
+`, config.ModuleName)
+	case "echo":
+		content = fmt.Sprintf(`package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	appcontext "%s/pkg/context"
+)
+
+// Me возвращает данные текущего пользователя
+// @Summary Текущий пользователь
+// @Description Возвращает ID и роли пользователя из токена
+// @Tags auth
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} map[string]interface{}
+// @Failure 401 {object} map[string]string
+// @Router /api/v1/me [get]
+func (h *Handler) Me(c echo.Context) error {
+	appCtx, ok := appcontext.FromContext(c.Request().Context())
+	if !ok {
+		return c.NoContent(http.StatusUnauthorized)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"user_id": appCtx.UserID(),
+		"roles":   appCtx.Roles(),
+	})
+}
+`, config.ModuleName)
+	default:
+		content = fmt.Sprintf(`package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	appcontext "%s/pkg/context"
+)
+
+// Me возвращает данные текущего пользователя
+// @Summary Текущий пользователь
+// @Description Возвращает ID и роли пользователя из токена
+// @Tags auth
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} map[string]interface{}
+// @Failure 401 {object} map[string]string
+// @Router /api/v1/me [get]
+func (h *Handler) Me(c *gin.Context) {
+	appCtx, ok := appcontext.FromContext(c.Request.Context())
+	if !ok {
+		c.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"user_id": appCtx.UserID(),
+		"roles":   appCtx.Roles(),
+	})
+}
+`, config.ModuleName)
+	}
+
+	authHandlerPath := filepath.Join(g.projectPath, "internal/handlers/auth.go")
+	if err := os.WriteFile(authHandlerPath, []byte(content), 0644); err != nil {
+		return err
+	}
+
+	authTestPath := filepath.Join(g.projectPath, "internal/handlers/auth_test.go")
+	return os.WriteFile(authTestPath, []byte(g.generateAuthHandlerTest()), 0644)
+}
+
+// generateAuthHandlerTest генерирует тест маршрутов API: rate limiting с key_by: user
+// должен получать пользователя из токена, а не считать всех клиентов одного IP вместе
+func (g *Generator) generateAuthHandlerTest() string {
+	return `package handlers
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// userRequest создает запрос с токеном пользователя user, если он задан
+func userRequest(t *testing.T, path, user string) *http.Request {
+	t.Helper()
+
+	req := newRequest(t, path)
+	if user != "" {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+			"sub": user,
+			"exp": time.Now().Add(time.Hour).Unix(),
+		}).SignedString([]byte(testSecret))
+		if err != nil {
+			t.Fatalf("Failed to sign token: %v", err)
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	return req
+}
+
+func TestRateLimitByUserOnSameIP(t *testing.T) {
+	router := newTestHandler(t, "user").SetupRoutes()
+
+	for _, user := range []string{"alice", "bob"} {
+		if code := serve(t, router, userRequest(t, "/api/v1/me", user)); code != http.StatusOK {
+			t.Fatalf("%s: expected 200, got %d", user, code)
+		}
+	}
+
+	if code := serve(t, router, userRequest(t, "/api/v1/me", "alice")); code != http.StatusTooManyRequests {
+		t.Errorf("alice: expected 429 after the limit, got %d", code)
+	}
+}
+
+func TestRateLimitAnonymousFallsBackToIP(t *testing.T) {
+	router := newTestHandler(t, "user").SetupRoutes()
+
+	if code := serve(t, router, userRequest(t, "/api/v1/ping", "")); code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", code)
+	}
+	if code := serve(t, router, userRequest(t, "/api/v1/ping", "")); code != http.StatusTooManyRequests {
+		t.Errorf("expected 429 for the same IP, got %d", code)
+	}
+	if code := serve(t, router, userRequest(t, "/api/v1/ping", "alice")); code != http.StatusOK {
+		t.Errorf("alice must not share the anonymous IP limit, got %d", code)
+	}
+}
+
+func TestProtectedRouteRejectsInvalidToken(t *testing.T) {
+	router := newTestHandler(t, "user").SetupRoutes()
+
+	req := newRequest(t, "/api/v1/me")
+	req.Header.Set("Authorization", "Bearer invalid")
+	if code := serve(t, router, req); code != http.StatusUnauthorized {
+		t.Errorf("expected 401, got %d", code)
+	}
+}
+`
+}
@@ -0,0 +1,602 @@
+package generator
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// hasCache проверяет, выбран ли кэш (--cache)
+func hasCache(config *ProjectConfig) bool {
+	return config.Cache != "" && config.Cache != "none"
+}
+
+// generateCache создает пакет pkg/cache и кэширующий UserRepository
+func (g *Generator) generateCache(config *ProjectConfig) error {
+	cacheDir := filepath.Join(g.projectPath, "pkg/cache")
+	if err := os.MkdirAll(cacheDir, 0755); err != nil {
+		return err
+	}
+
+	files := map[string]string{
+		"pkg/cache/cache.go":       g.generateCacheCore(config),
+		"pkg/cache/memory.go":      g.generateCacheMemory(),
+		"pkg/cache/redis.go":       g.generateCacheRedis(config),
+		"pkg/cache/memory_test.go": g.generateCacheTest(),
+	}
+
+	// В ClickHouse нет UserRepository, кэшируются только собственные данные сервиса
+	if !strings.Contains(strings.ToLower(config.Database), "без") && repositoryKind(config) != "clickhouse" {
+		files["internal/repository/user_cache.go"] = g.generateCachedUserRepository(config)
+		if isSQLite(config) {
+			files["internal/repository/user_cache_test.go"] = g.generateCachedUserRepositoryTest(config)
+		}
+	}
+
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(g.projectPath, name), []byte(content), 0644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// generateCacheCore генерирует интерфейс Cache и помощники read-through/write-through
+func (g *Generator) generateCacheCore(config *ProjectConfig) string {
+	return fmt.Sprintf(`package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"%s/internal/config"
+)
+
+// ErrMiss ключа нет в кэше или срок его жизни истек
+var ErrMiss = errors.New("ключ не найден в кэше")
+
+// Cache хранит значения по ключу с ограниченным временем жизни
+type Cache interface {
+	Get(ctx context.Context, key string) ([]byte, error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	Delete(ctx context.Context, keys ...string) error
+	Close() error
+}
+
+// New создает Cache для выбранного backend
+func New(cfg config.CacheConfig) (Cache, error) {
+	switch cfg.Backend {
+	case "", "memory":
+		return NewMemoryCache(), nil
+	case "redis":
+		return NewRedisCache(cfg.Redis)
+	default:
+		return nil, fmt.Errorf("неизвестный backend кэша: %%s", cfg.Backend)
+	}
+}
+
+// TTL возвращает время жизни записей по умолчанию, cache.ttl в секундах
+func TTL(cfg config.CacheConfig) time.Duration {
+	if cfg.TTL <= 0 {
+		return 5 * time.Minute
+	}
+	return time.Duration(cfg.TTL) * time.Second
+}
+
+// GetOrLoad читает значение через кэш (read-through): при промахе вызывает load и сохраняет
+// результат на ttl. Ошибки кэша не прерывают запрос, значение берется из load.
+// Ошибки load не кэшируются
+func GetOrLoad[T any](ctx context.Context, c Cache, key string, ttl time.Duration, load func(ctx context.Context) (T, error)) (T, error) {
+	var value T
+	if data, err := c.Get(ctx, key); err == nil {
+		if err := json.Unmarshal(data, &value); err == nil {
+			return value, nil
+		}
+	}
+
+	value, err := load(ctx)
+	if err != nil {
+		return value, err
+	}
+
+	_ = Store(ctx, c, key, value, ttl)
+	return value, nil
+}
+
+// Store сохраняет значение в JSON, вызывается после записи в источник (write-through)
+func Store[T any](ctx context.Context, c Cache, key string, value T, ttl time.Duration) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("ошибка сериализации значения для кэша: %%w", err)
+	}
+	return c.Set(ctx, key, data, ttl)
+}
+`, config.ModuleName)
+}
+
+// generateCacheMemory генерирует in-memory кэш для тестов и локального запуска
+func (g *Generator) generateCacheMemory() string {
+	return `package cache
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// memoryItem значение и момент истечения, нулевой - без срока
+type memoryItem struct {
+	value   []byte
+	expires time.Time
+}
+
+// MemoryCache кэш в памяти процесса, не разделяется между экземплярами сервиса
+type MemoryCache struct {
+	mu    sync.Mutex
+	items map[string]memoryItem
+	now   func() time.Time
+}
+
+// NewMemoryCache создает пустой in-memory кэш
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{items: map[string]memoryItem{}, now: time.Now}
+}
+
+// Get возвращает значение или ErrMiss, истекшие записи удаляются при чтении
+func (c *MemoryCache) Get(_ context.Context, key string) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	item, ok := c.items[key]
+	if !ok {
+		return nil, ErrMiss
+	}
+	if !item.expires.IsZero() && !c.now().Before(item.expires) {
+		delete(c.items, key)
+		return nil, ErrMiss
+	}
+	return append([]byte(nil), item.value...), nil
+}
+
+// Set сохраняет копию значения на ttl, ttl <= 0 - без срока
+func (c *MemoryCache) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	item := memoryItem{value: append([]byte(nil), value...)}
+	if ttl > 0 {
+		item.expires = c.now().Add(ttl)
+	}
+
+	c.mu.Lock()
+	c.items[key] = item
+	c.mu.Unlock()
+	return nil
+}
+
+// Delete удаляет ключи, отсутствующие пропускаются
+func (c *MemoryCache) Delete(_ context.Context, keys ...string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, key := range keys {
+		delete(c.items, key)
+	}
+	return nil
+}
+
+// Close очищает кэш
+func (c *MemoryCache) Close() error {
+	c.mu.Lock()
+	c.items = map[string]memoryItem{}
+	c.mu.Unlock()
+	return nil
+}
+`
+}
+
+// generateCacheRedis генерирует кэш на Redis
+func (g *Generator) generateCacheRedis(config *ProjectConfig) string {
+	return fmt.Sprintf(`package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"%s/internal/config"
+)
+
+// RedisCache кэш в Redis, общий для всех экземпляров сервиса
+type RedisCache struct {
+	client *redis.Client
+	prefix string
+}
+
+// NewRedisCache подключается к Redis
+func NewRedisCache(cfg config.CacheRedisConfig) (*RedisCache, error) {
+	client := redis.NewClient(&redis.Options{
+		Addr:     cfg.Addr,
+		Password: cfg.Password,
+		DB:       cfg.DB,
+	})
+
+	if err := client.Ping(context.Background()).Err(); err != nil {
+		client.Close()
+		return nil, fmt.Errorf("ошибка подключения к Redis: %%w", err)
+	}
+
+	prefix := cfg.Prefix
+	if prefix == "" {
+		prefix = "cache"
+	}
+
+	return &RedisCache{client: client, prefix: prefix}, nil
+}
+
+// Get возвращает значение или ErrMiss
+func (c *RedisCache) Get(ctx context.Context, key string) ([]byte, error) {
+	value, err := c.client.Get(ctx, c.key(key)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrMiss
+	}
+	return value, err
+}
+
+// Set сохраняет значение на ttl, ttl <= 0 - без срока
+func (c *RedisCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	if ttl < 0 {
+		ttl = 0
+	}
+	return c.client.Set(ctx, c.key(key), value, ttl).Err()
+}
+
+// Delete удаляет ключи
+func (c *RedisCache) Delete(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	prefixed := make([]string, len(keys))
+	for i, key := range keys {
+		prefixed[i] = c.key(key)
+	}
+	return c.client.Del(ctx, prefixed...).Err()
+}
+
+// Client возвращает клиент Redis, например для проверки состояния
+func (c *RedisCache) Client() *redis.Client {
+	return c.client
+}
+
+// Close закрывает соединение с Redis
+func (c *RedisCache) Close() error {
+	return c.client.Close()
+}
+
+// key добавляет префикс сервиса к ключу
+func (c *RedisCache) key(key string) string {
+	return c.prefix + ":" + key
+}
+`, config.ModuleName)
+}
+
+// generateCacheTest генерирует тесты in-memory кэша и GetOrLoad
+func (g *Generator) generateCacheTest() string {
+	return `package cache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestMemoryCacheTTL(t *testing.T) {
+	c := NewMemoryCache()
+	now := time.Now()
+	c.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	if err := c.Set(ctx, "key", []byte("value"), time.Minute); err != nil {
+		t.Fatalf("Set failed: %v", err)
+	}
+	if value, err := c.Get(ctx, "key"); err != nil || string(value) != "value" {
+		t.Fatalf("Get = %q, %v", value, err)
+	}
+
+	now = now.Add(time.Minute)
+	if _, err := c.Get(ctx, "key"); !errors.Is(err, ErrMiss) {
+		t.Errorf("expected ErrMiss after TTL, got %v", err)
+	}
+}
+
+func TestGetOrLoad(t *testing.T) {
+	c := NewMemoryCache()
+	ctx := context.Background()
+
+	loads := 0
+	load := func(context.Context) (map[string]int, error) {
+		loads++
+		return map[string]int{"answer": 42}, nil
+	}
+
+	for i := 0; i < 3; i++ {
+		value, err := GetOrLoad(ctx, c, "answer", time.Minute, load)
+		if err != nil || value["answer"] != 42 {
+			t.Fatalf("GetOrLoad = %v, %v", value, err)
+		}
+	}
+	if loads != 1 {
+		t.Errorf("expected a single load, got %d", loads)
+	}
+
+	if err := c.Delete(ctx, "answer"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if _, err := GetOrLoad(ctx, c, "answer", time.Minute, load); err != nil || loads != 2 {
+		t.Errorf("expected reload after Delete, loads = %d, err = %v", loads, err)
+	}
+}
+
+func TestGetOrLoadDoesNotCacheErrors(t *testing.T) {
+	c := NewMemoryCache()
+	ctx := context.Background()
+	errLoad := errors.New("load failed")
+
+	_, err := GetOrLoad(ctx, c, "key", time.Minute, func(context.Context) (string, error) {
+		return "", errLoad
+	})
+	if !errors.Is(err, errLoad) {
+		t.Fatalf("expected load error, got %v", err)
+	}
+	if _, err := c.Get(ctx, "key"); !errors.Is(err, ErrMiss) {
+		t.Errorf("failed load must not be cached, got %v", err)
+	}
+}
+`
+}
+
+// generateCachedUserRepository генерирует UserRepository с кэшем пользователей по ID
+func (g *Generator) generateCachedUserRepository(config *ProjectConfig) string {
+	return fmt.Sprintf(`package repository
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"%s/internal/models"
+	"%s/pkg/cache"
+	"%s/pkg/database"
+)
+
+// CachedUserRepository кэширует пользователей по ID: GetByID читает через кэш, Create и Update
+// обновляют его, Delete удаляет запись. Внутри транзакции кэш не читается и не заполняется,
+// а запись сбрасывается после подтверждения (database.AfterCommit): откат не оставит в кэше
+// незафиксированные данные, а старая запись, закэшированная параллельным GetByID до подтверждения,
+// не переживет транзакцию
+type CachedUserRepository struct {
+	UserRepository
+	cache cache.Cache
+	ttl   time.Duration
+	// ctx контекст запросов, если репозиторий создан через WithContext или WithTx
+	ctx context.Context
+}
+
+// NewCachedUserRepository оборачивает repo кэшем, записи живут ttl
+func NewCachedUserRepository(repo UserRepository, c cache.Cache, ttl time.Duration) UserRepository {
+	return &CachedUserRepository{UserRepository: repo, cache: c, ttl: ttl}
+}
+
+// WithContext возвращает копию репозитория, выполняющую запросы с контекстом ctx
+func (r *CachedUserRepository) WithContext(ctx context.Context) UserRepository {
+	return &CachedUserRepository{
+		UserRepository: r.UserRepository.WithContext(ctx),
+		cache:          r.cache,
+		ttl:            r.ttl,
+		ctx:            ctx,
+	}
+}
+
+// WithTx возвращает копию репозитория, работающую в транзакции tx
+func (r *CachedUserRepository) WithTx(tx database.Tx) UserRepository {
+	return r.WithContext(tx.Context())
+}
+
+// GetByID получает пользователя из кэша или из БД
+func (r *CachedUserRepository) GetByID(id int64) (*models.User, error) {
+	ctx := r.requestContext()
+	if database.TxFromContext(ctx) != nil {
+		return r.UserRepository.GetByID(id)
+	}
+
+	return cache.GetOrLoad(ctx, r.cache, userCacheKey(id), r.ttl, func(context.Context) (*models.User, error) {
+		return r.UserRepository.GetByID(id)
+	})
+}
+
+// Create создает пользователя и кладет его в кэш
+func (r *CachedUserRepository) Create(user *models.User) error {
+	if err := r.UserRepository.Create(user); err != nil {
+		return err
+	}
+	return r.store(user)
+}
+
+// Update обновляет пользователя и запись в кэше
+func (r *CachedUserRepository) Update(user *models.User) error {
+	if err := r.UserRepository.Update(user); err != nil {
+		return err
+	}
+	return r.store(user)
+}
+
+// Delete удаляет пользователя и запись в кэше
+func (r *CachedUserRepository) Delete(id int64) error {
+	if err := r.UserRepository.Delete(id); err != nil {
+		return err
+	}
+
+	ctx := r.requestContext()
+	if database.TxFromContext(ctx) != nil {
+		r.invalidateAfterCommit(ctx, id)
+		return nil
+	}
+	return r.cache.Delete(ctx, userCacheKey(id))
+}
+
+// store обновляет запись в кэше после записи в БД, в транзакции - сбрасывает ее после подтверждения
+func (r *CachedUserRepository) store(user *models.User) error {
+	ctx := r.requestContext()
+	if database.TxFromContext(ctx) != nil {
+		r.invalidateAfterCommit(ctx, user.ID)
+		return nil
+	}
+	return cache.Store(ctx, r.cache, userCacheKey(user.ID), user, r.ttl)
+}
+
+// invalidateAfterCommit сбрасывает запись после подтверждения транзакции. Транзакция уже
+// подтверждена, поэтому ошибка кэша не возвращается: в худшем случае запись устареет на ttl
+func (r *CachedUserRepository) invalidateAfterCommit(ctx context.Context, id int64) {
+	database.AfterCommit(ctx, func() {
+		_ = r.cache.Delete(context.WithoutCancel(ctx), userCacheKey(id))
+	})
+}
+
+// requestContext возвращает контекст из WithContext, контекст запроса из AppContext или context.Background()
+func (r *CachedUserRepository) requestContext() context.Context {
+	if r.ctx != nil {
+		return r.ctx
+	}
+	if appCtx := r.GetContext(); appCtx != nil && appCtx.Context() != nil {
+		return appCtx.Context()
+	}
+	return context.Background()
+}
+
+// userCacheKey ключ пользователя в кэше
+func userCacheKey(id int64) string {
+	return "user:" + strconv.FormatInt(id, 10)
+}
+`, config.ModuleName, config.ModuleName, config.ModuleName)
+}
+
+// generateCachedUserRepositoryTest генерирует тесты кэширующего репозитория на SQLite в памяти
+func (g *Generator) generateCachedUserRepositoryTest(config *ProjectConfig) string {
+	return `package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"` + config.ModuleName + `/internal/models"
+	"` + config.ModuleName + `/pkg/cache"
+	"` + config.ModuleName + `/pkg/database"
+)
+
+func TestCachedUserRepository(t *testing.T) {
+	repo, _ := newTestRepository(t)
+	c := cache.NewMemoryCache()
+	cached := NewCachedUserRepository(repo, c, time.Minute)
+	ctx := context.Background()
+
+	user := &models.User{Email: "a@example.com", Name: "A"}
+	if err := cached.Create(user); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if _, err := c.Get(ctx, userCacheKey(user.ID)); err != nil {
+		t.Fatalf("Create must write through to cache: %v", err)
+	}
+
+	// Изменение в обход кэша не видно до истечения TTL
+	stale := *user
+	stale.Name = "B"
+	if err := repo.Update(&stale); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	if got, err := cached.GetByID(user.ID); err != nil || got.Name != "A" {
+		t.Fatalf("GetByID must read from cache, got %+v, %v", got, err)
+	}
+
+	user.Name = "C"
+	if err := cached.Update(user); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	if got, err := cached.GetByID(user.ID); err != nil || got.Name != "C" {
+		t.Fatalf("GetByID after cached Update = %+v, %v", got, err)
+	}
+
+	if err := cached.Delete(user.ID); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if _, err := cached.GetByID(user.ID); !errors.Is(err, database.ErrNotFound) {
+		t.Errorf("GetByID after Delete: expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestCachedUserRepositorySkipsCacheInTransaction(t *testing.T) {
+	repo, db := newTestRepository(t)
+	c := cache.NewMemoryCache()
+	cached := NewCachedUserRepository(repo, c, time.Minute)
+
+	user := &models.User{Email: "tx@example.com", Name: "T"}
+	errRollback := errors.New("rollback")
+	err := db.WithinTransaction(context.Background(), func(ctx context.Context) error {
+		if err := cached.WithContext(ctx).Create(user); err != nil {
+			return err
+		}
+		if _, err := cached.WithContext(ctx).GetByID(user.ID); err != nil {
+			return err
+		}
+		return errRollback
+	})
+	if !errors.Is(err, errRollback) {
+		t.Fatalf("expected rollback error, got %v", err)
+	}
+
+	if _, err := c.Get(context.Background(), userCacheKey(user.ID)); !errors.Is(err, cache.ErrMiss) {
+		t.Errorf("rolled back user must not be cached, got %v", err)
+	}
+	if _, err := cached.GetByID(user.ID); !errors.Is(err, database.ErrNotFound) {
+		t.Errorf("expected ErrNotFound for rolled back user, got %v", err)
+	}
+}
+
+func TestCachedUserRepositoryInvalidatesAfterCommit(t *testing.T) {
+	repo, db := newTestRepository(t)
+	c := cache.NewMemoryCache()
+	cached := NewCachedUserRepository(repo, c, time.Minute)
+	ctx := context.Background()
+
+	user := &models.User{Email: "commit@example.com", Name: "A"}
+	if err := cached.Create(user); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	err := db.WithinTransaction(ctx, func(txCtx context.Context) error {
+		updated := *user
+		updated.Name = "B"
+		if err := cached.WithContext(txCtx).Update(&updated); err != nil {
+			return err
+		}
+		// Параллельный GetByID вне транзакции до подтверждения кэширует старую запись
+		return cache.Store(ctx, c, userCacheKey(user.ID), user, time.Minute)
+	})
+	if err != nil {
+		t.Fatalf("transaction failed: %v", err)
+	}
+
+	if got, err := cached.GetByID(user.ID); err != nil || got.Name != "B" {
+		t.Errorf("GetByID after commit must not return stale cache, got %+v, %v", got, err)
+	}
+}
+`
+}
@@ -0,0 +1,296 @@
+package generator
+
+// generateClickHouseImplementation генерирует реализацию для ClickHouse на database/sql с драйвером clickhouse-go.
+// ClickHouse не поддерживает транзакции, вместо них Database предоставляет пакетную вставку Batch
+func (g *Generator) generateClickHouseImplementation(config *ProjectConfig) string {
+	return `package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	_ "github.com/ClickHouse/clickhouse-go/v2"
+
+	"` + config.ModuleName + `/internal/config"
+)
+
+// ErrTxNotSupported возвращают BeginTx и WithinTransaction: ClickHouse не поддерживает транзакции
+var ErrTxNotSupported = errors.New("ClickHouse не поддерживает транзакции")
+
+// ClickHouseDatabase реализация для ClickHouse на database/sql
+type ClickHouseDatabase struct {
+	db     *sql.DB
+	config *config.Config
+}
+
+// New создает новое подключение к ClickHouse
+func New(cfg *config.Config) (Database, error) {
+	db, err := sql.Open("clickhouse", cfg.GetDSN())
+	if err != nil {
+		return nil, fmt.Errorf("ошибка подключения к ClickHouse: %w", err)
+	}
+
+	// Настройка пула соединений
+	db.SetMaxOpenConns(cfg.Database.MaxConnections)
+	db.SetMaxIdleConns(cfg.Database.MaxIdleConnections)
+	db.SetConnMaxLifetime(time.Hour)
+
+	// Open не устанавливает соединение, проверяем доступность БД сразу
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("ошибка подключения к ClickHouse: %w", err)
+	}
+
+	return &ClickHouseDatabase{
+		db:     db,
+		config: cfg,
+	}, nil
+}
+
+// Connect подключается к БД
+func (c *ClickHouseDatabase) Connect() error {
+	return c.db.Ping()
+}
+
+// Close закрывает подключение
+func (c *ClickHouseDatabase) Close() error {
+	return c.db.Close()
+}
+
+// Ping проверяет подключение
+func (c *ClickHouseDatabase) Ping() error {
+	return c.db.Ping()
+}
+
+// BeginTx возвращает ErrTxNotSupported, для пакетной вставки используйте Batch
+func (c *ClickHouseDatabase) BeginTx(ctx context.Context) (Tx, error) {
+	return nil, ErrTxNotSupported
+}
+
+// WithinTransaction возвращает ErrTxNotSupported, fn не вызывается
+func (c *ClickHouseDatabase) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return ErrTxNotSupported
+}
+
+// Batch выполняет пакетную вставку: query - INSERT без VALUES, rows добавляет строки через stmt.ExecContext.
+// Строки отправляются на сервер одним блоком, если rows вернула nil, иначе отбрасываются.
+// В clickhouse-go транзакция database/sql - это пакет вставки, а не транзакция ClickHouse
+func (c *ClickHouseDatabase) Batch(ctx context.Context, query string, rows func(stmt *sql.Stmt) error) error {
+	batch, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	stmt, err := batch.PrepareContext(ctx, query)
+	if err != nil {
+		_ = batch.Rollback()
+		return err
+	}
+	defer stmt.Close()
+
+	if err := rows(stmt); err != nil {
+		_ = batch.Rollback()
+		return err
+	}
+
+	return batch.Commit()
+}
+
+// Migrate применяет миграции из migrations/ до последней версии
+func (c *ClickHouseDatabase) Migrate() error {
+	return MigrateCommand(context.Background(), c, "up")
+}
+
+// sqlDB возвращает пул database/sql для миграций
+func (c *ClickHouseDatabase) sqlDB() (*sql.DB, error) {
+	return c.db, nil
+}
+
+// Stats возвращает статистику
+func (c *ClickHouseDatabase) Stats() Stats {
+	stats := c.db.Stats()
+	return Stats{
+		OpenConnections:  stats.OpenConnections,
+		InUseConnections: stats.InUse,
+		IdleConnections:  stats.Idle,
+	}
+}
+
+// DB возвращает пул соединений
+func (c *ClickHouseDatabase) DB() *sql.DB {
+	return c.db
+}
+`
+}
+
+// generateEventsMigration генерирует миграцию таблицы events для модели Event
+func (g *Generator) generateEventsMigration() string {
+	return `-- +goose Up
+CREATE TABLE events (
+    id UUID DEFAULT generateUUIDv4(),
+    type LowCardinality(String),
+    user_id Int64,
+    payload String,
+    created_at DateTime64(3) DEFAULT now64(3)
+) ENGINE = MergeTree
+PARTITION BY toYYYYMM(created_at)
+ORDER BY (type, created_at);
+
+-- +goose Down
+DROP TABLE events;
+`
+}
+
+// generateEventModel генерирует модель Event для ClickHouse
+func (g *Generator) generateEventModel() string {
+	return `package models
+
+import (
+	"time"
+)
+
+// Event аналитическое событие
+type Event struct {
+	ID        string    ` + "`" + `json:"id"` + "`" + `
+	Type      string    ` + "`" + `json:"type"` + "`" + `
+	UserID    int64     ` + "`" + `json:"user_id"` + "`" + `
+	Payload   string    ` + "`" + `json:"payload"` + "`" + `
+	CreatedAt time.Time ` + "`" + `json:"created_at"` + "`" + `
+}
+
+// TableName возвращает имя таблицы
+func (Event) TableName() string {
+	return "events"
+}
+`
+}
+
+// generateEventRepository генерирует EventRepository: пакетная запись и агрегирующие запросы к ClickHouse
+func (g *Generator) generateEventRepository(config *ProjectConfig) string {
+	return `package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"` + config.ModuleName + `/internal/models"
+	"` + config.ModuleName + `/pkg/database"
+)
+
+// EventRepository интерфейс для записи и чтения аналитических событий
+type EventRepository interface {
+	database.Repository
+	// Insert записывает события одной пакетной вставкой
+	Insert(events ...*models.Event) error
+	// CountByType возвращает число событий каждого типа, созданных начиная с since
+	CountByType(since time.Time) (map[string]uint64, error)
+	// ListByType возвращает последние limit событий типа eventType
+	ListByType(eventType string, limit int) ([]*models.Event, error)
+	// WithContext возвращает репозиторий, выполняющий запросы с контекстом ctx
+	WithContext(ctx context.Context) EventRepository
+}
+
+// EventRepositoryImpl реализация репозитория событий
+type EventRepositoryImpl struct {
+	*database.BaseRepository
+	db *database.ClickHouseDatabase
+	// ctx контекст запросов, если репозиторий создан через WithContext
+	ctx context.Context
+}
+
+// NewEventRepository создает новый репозиторий событий
+func NewEventRepository(db database.Database) EventRepository {
+	return &EventRepositoryImpl{
+		BaseRepository: database.NewBaseRepository(db),
+		db:             db.(*database.ClickHouseDatabase),
+	}
+}
+
+// WithContext возвращает копию репозитория, выполняющую запросы с контекстом ctx
+func (r *EventRepositoryImpl) WithContext(ctx context.Context) EventRepository {
+	repo := *r
+	base := *r.BaseRepository
+	repo.BaseRepository, repo.ctx = &base, ctx
+	return &repo
+}
+
+// requestContext возвращает контекст из WithContext, контекст запроса из AppContext или context.Background()
+func (r *EventRepositoryImpl) requestContext() context.Context {
+	if r.ctx != nil {
+		return r.ctx
+	}
+	if appCtx := r.GetContext(); appCtx != nil && appCtx.Context() != nil {
+		return appCtx.Context()
+	}
+	return context.Background()
+}
+
+// Insert записывает события одной пакетной вставкой, пустой CreatedAt заполняется текущим временем.
+// ID назначает ClickHouse, в events он не возвращается
+func (r *EventRepositoryImpl) Insert(events ...*models.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	ctx := r.requestContext()
+	now := time.Now()
+	return r.db.Batch(ctx, "INSERT INTO events (type, user_id, payload, created_at)", func(stmt *sql.Stmt) error {
+		for _, event := range events {
+			if event.CreatedAt.IsZero() {
+				event.CreatedAt = now
+			}
+			if _, err := stmt.ExecContext(ctx, event.Type, event.UserID, event.Payload, event.CreatedAt); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// CountByType возвращает число событий каждого типа, созданных начиная с since
+func (r *EventRepositoryImpl) CountByType(since time.Time) (map[string]uint64, error) {
+	rows, err := r.db.DB().QueryContext(r.requestContext(),
+		"SELECT type, count() FROM events WHERE created_at >= ? GROUP BY type", since)
+	if err != nil {
+		return nil, database.TranslateError(err)
+	}
+	defer rows.Close()
+
+	counts := make(map[string]uint64)
+	for rows.Next() {
+		var eventType string
+		var count uint64
+		if err := rows.Scan(&eventType, &count); err != nil {
+			return nil, err
+		}
+		counts[eventType] = count
+	}
+	return counts, rows.Err()
+}
+
+// ListByType возвращает последние limit событий типа eventType, новые первыми
+func (r *EventRepositoryImpl) ListByType(eventType string, limit int) ([]*models.Event, error) {
+	rows, err := r.db.DB().QueryContext(r.requestContext(),
+		"SELECT toString(id), type, user_id, payload, created_at FROM events WHERE type = ? ORDER BY created_at DESC LIMIT ?",
+		eventType, limit)
+	if err != nil {
+		return nil, database.TranslateError(err)
+	}
+	defer rows.Close()
+
+	var events []*models.Event
+	for rows.Next() {
+		var event models.Event
+		if err := rows.Scan(&event.ID, &event.Type, &event.UserID, &event.Payload, &event.CreatedAt); err != nil {
+			return nil, err
+		}
+		events = append(events, &event)
+	}
+	return events, rows.Err()
+}
+`
+}
@@ -0,0 +1,391 @@
+package generator
+
+import "fmt"
+
+// generateCockroachDBImplementation генерирует реализацию для CockroachDB на GORM.
+// CockroachDB совместим с протоколом PostgreSQL, поэтому используется драйвер gorm.io/driver/postgres
+func (g *Generator) generateCockroachDBImplementation(config *ProjectConfig) string {
+	return fmt.Sprintf(`package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"`+gormTracingImport(config)+`
+
+	"%s/internal/config"
+)
+
+// CockroachDBDatabase реализация для CockroachDB
+type CockroachDBDatabase struct {
+	db     *gorm.DB
+	config *config.Config
+}
+
+// CockroachDBTx реализация транзакции для CockroachDB
+type CockroachDBTx struct {
+	tx  *gorm.DB
+	ctx context.Context
+}
+
+// New создает новое подключение к CockroachDB
+func New(cfg *config.Config) (Database, error) {
+	// TranslateError приводит ошибки уникальности драйвера к gorm.ErrDuplicatedKey
+	gormConfig := &gorm.Config{
+		Logger:         logger.Default.LogMode(logger.Info),
+		TranslateError: true,
+	}
+
+	if !cfg.App.Debug {
+		gormConfig.Logger = logger.Default.LogMode(logger.Silent)
+	}
+
+	db, err := gorm.Open(postgres.Open(cfg.GetDSN()), gormConfig)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка подключения к CockroachDB: %%w", err)
+	}
+`+gormTracingSetup(config)+`
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, fmt.Errorf("ошибка получения sql.DB: %%w", err)
+	}
+
+	// Настройка пула соединений
+	sqlDB.SetMaxOpenConns(cfg.Database.MaxConnections)
+	sqlDB.SetMaxIdleConns(cfg.Database.MaxIdleConnections)
+	sqlDB.SetConnMaxLifetime(time.Hour)
+
+	return &CockroachDBDatabase{
+		db:     db,
+		config: cfg,
+	}, nil
+}
+
+// Connect подключается к БД
+func (c *CockroachDBDatabase) Connect() error {
+	sqlDB, err := c.db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.Ping()
+}
+
+// Close закрывает подключение
+func (c *CockroachDBDatabase) Close() error {
+	sqlDB, err := c.db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.Close()
+}
+
+// Ping проверяет подключение
+func (c *CockroachDBDatabase) Ping() error {
+	sqlDB, err := c.db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.Ping()
+}
+
+// BeginTx начинает транзакцию
+func (c *CockroachDBDatabase) BeginTx(ctx context.Context) (Tx, error) {
+	tx := c.db.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	t := &CockroachDBTx{tx: tx}
+	t.ctx = contextWithTx(ctx, t)
+	return t, nil
+}
+
+`+withinTransactionMethod(config, "c", "CockroachDB")+`
+
+// Migrate применяет миграции из migrations/ до последней версии
+func (c *CockroachDBDatabase) Migrate() error {
+	return MigrateCommand(context.Background(), c, "up")
+}
+
+// sqlDB возвращает пул database/sql для миграций
+func (c *CockroachDBDatabase) sqlDB() (*sql.DB, error) {
+	return c.db.DB()
+}
+
+// Stats возвращает статистику
+func (c *CockroachDBDatabase) Stats() Stats {
+	sqlDB, err := c.db.DB()
+	if err != nil {
+		return Stats{}
+	}
+
+	stats := sqlDB.Stats()
+	return Stats{
+		OpenConnections:  stats.OpenConnections,
+		InUseConnections: stats.InUse,
+		IdleConnections:  stats.Idle,
+	}
+}
+
+// DB возвращает GORM DB
+func (c *CockroachDBDatabase) DB() *gorm.DB {
+	return c.db
+}
+
+// Commit подтверждает транзакцию
+func (tx *CockroachDBTx) Commit() error {
+	if err := tx.tx.Commit().Error; err != nil {
+		return err
+	}
+	runAfterCommit(tx.ctx)
+	return nil
+}
+
+// Rollback откатывает транзакцию
+func (tx *CockroachDBTx) Rollback() error {
+	return tx.tx.Rollback().Error
+}
+
+// Context возвращает контекст транзакции
+func (tx *CockroachDBTx) Context() context.Context {
+	return tx.ctx
+}
+
+// DB возвращает транзакцию GORM для запросов репозиториев
+func (tx *CockroachDBTx) DB() *gorm.DB {
+	return tx.tx
+}
+
+// Savepoint создает точку сохранения для вложенного WithinTransaction
+func (tx *CockroachDBTx) Savepoint(name string) error {
+	return tx.tx.SavePoint(name).Error
+}
+
+// RollbackTo откатывает транзакцию к точке сохранения
+func (tx *CockroachDBTx) RollbackTo(name string) error {
+	return tx.tx.RollbackTo(name).Error
+}
+`, config.ModuleName)
+}
+
+// generateTxRetry генерирует повтор транзакций CockroachDB при ошибках сериализации.
+// CockroachDB выполняет транзакции в SERIALIZABLE и при конфликте требует повторить транзакцию целиком
+func (g *Generator) generateTxRetry() string {
+	return `package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// maxTxAttempts максимальное число попыток транзакции WithinTransaction
+const maxTxAttempts = 5
+
+// retryBackoff пауза перед второй попыткой, перед каждой следующей она удваивается
+var retryBackoff = 50 * time.Millisecond
+
+// IsRetryable проверяет, что CockroachDB отменил транзакцию из-за конфликта (SQLSTATE 40001)
+// и ее можно выполнить заново
+func IsRetryable(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "40001"
+}
+
+// withinRetryableTransaction выполняет withinTransaction и повторяет транзакцию целиком, пока она
+// отменяется с IsRetryable, но не больше maxTxAttempts раз. fn не должна иметь побочных эффектов
+// вне транзакции. Вложенный вызов не повторяется сам: ошибка доходит до внешней транзакции
+func withinRetryableTransaction(ctx context.Context, db Database, fn func(ctx context.Context) error) error {
+	if TxFromContext(ctx) != nil {
+		return withinTransaction(ctx, db, fn)
+	}
+
+	backoff := retryBackoff
+	for attempt := 1; ; attempt++ {
+		err := withinTransaction(ctx, db, fn)
+		if attempt == maxTxAttempts || !IsRetryable(err) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.Join(err, ctx.Err())
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+`
+}
+
+// generateTxRetryTest генерирует тесты повтора транзакций на фиктивной Database
+func (g *Generator) generateTxRetryTest() string {
+	return `package database
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// fakeDatabase считает начатые, подтвержденные и откаченные транзакции
+type fakeDatabase struct {
+	begun, committed, rolledBack int
+	// commitErr ошибки Commit по порядку попыток
+	commitErr []error
+}
+
+type fakeTx struct {
+	db  *fakeDatabase
+	ctx context.Context
+}
+
+func (d *fakeDatabase) Connect() error { return nil }
+func (d *fakeDatabase) Close() error   { return nil }
+func (d *fakeDatabase) Ping() error    { return nil }
+func (d *fakeDatabase) Migrate() error { return nil }
+func (d *fakeDatabase) Stats() Stats   { return Stats{} }
+
+func (d *fakeDatabase) BeginTx(ctx context.Context) (Tx, error) {
+	d.begun++
+	t := &fakeTx{db: d}
+	t.ctx = contextWithTx(ctx, t)
+	return t, nil
+}
+
+func (d *fakeDatabase) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return withinRetryableTransaction(ctx, d, fn)
+}
+
+func (t *fakeTx) Commit() error {
+	t.db.committed++
+	if len(t.db.commitErr) > 0 {
+		err := t.db.commitErr[0]
+		t.db.commitErr = t.db.commitErr[1:]
+		return err
+	}
+	return nil
+}
+
+func (t *fakeTx) Rollback() error {
+	t.db.rolledBack++
+	return nil
+}
+
+func (t *fakeTx) Context() context.Context { return t.ctx }
+
+var errSerialization = &pgconn.PgError{Code: "40001", Message: "restart transaction"}
+
+func init() {
+	retryBackoff = time.Millisecond
+}
+
+func TestRetryOnSerializationError(t *testing.T) {
+	db := &fakeDatabase{}
+	calls := 0
+
+	err := db.WithinTransaction(context.Background(), func(ctx context.Context) error {
+		calls++
+		if calls < 3 {
+			return errSerialization
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("WithinTransaction failed: %v", err)
+	}
+	if calls != 3 || db.begun != 3 || db.rolledBack != 2 || db.committed != 1 {
+		t.Errorf("calls=%d begun=%d rolledBack=%d committed=%d, want 3 3 2 1", calls, db.begun, db.rolledBack, db.committed)
+	}
+}
+
+func TestRetryOnCommitSerializationError(t *testing.T) {
+	db := &fakeDatabase{commitErr: []error{errSerialization}}
+
+	err := db.WithinTransaction(context.Background(), func(ctx context.Context) error {
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("WithinTransaction failed: %v", err)
+	}
+	if db.begun != 2 || db.committed != 2 {
+		t.Errorf("begun=%d committed=%d, want 2 2", db.begun, db.committed)
+	}
+}
+
+func TestRetryGivesUpAfterMaxAttempts(t *testing.T) {
+	db := &fakeDatabase{}
+
+	err := db.WithinTransaction(context.Background(), func(ctx context.Context) error {
+		return errSerialization
+	})
+	if !IsRetryable(err) {
+		t.Fatalf("expected serialization error, got %v", err)
+	}
+	if db.begun != maxTxAttempts {
+		t.Errorf("begun=%d, want %d", db.begun, maxTxAttempts)
+	}
+}
+
+func TestNoRetryOnOtherErrors(t *testing.T) {
+	db := &fakeDatabase{}
+	errFailed := errors.New("failed")
+
+	err := db.WithinTransaction(context.Background(), func(ctx context.Context) error {
+		return errFailed
+	})
+	if !errors.Is(err, errFailed) {
+		t.Fatalf("expected errFailed, got %v", err)
+	}
+	if db.begun != 1 {
+		t.Errorf("begun=%d, want 1", db.begun)
+	}
+}
+
+func TestNestedTransactionNotRetried(t *testing.T) {
+	db := &fakeDatabase{}
+	inner := 0
+
+	err := db.WithinTransaction(context.Background(), func(ctx context.Context) error {
+		return db.WithinTransaction(ctx, func(ctx context.Context) error {
+			inner++
+			if inner == 1 {
+				return errSerialization
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		t.Fatalf("WithinTransaction failed: %v", err)
+	}
+	if inner != 2 || db.begun != 2 {
+		t.Errorf("inner=%d begun=%d, want the outer transaction retried once", inner, db.begun)
+	}
+}
+
+func TestRetryStopsWhenContextDone(t *testing.T) {
+	db := &fakeDatabase{}
+	ctx, cancel := context.WithCancel(context.Background())
+
+	err := db.WithinTransaction(ctx, func(ctx context.Context) error {
+		cancel()
+		return errSerialization
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if db.begun != 1 {
+		t.Errorf("begun=%d, want 1", db.begun)
+	}
+}
+`
+}
@@ -0,0 +1,1251 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// dataAccess возвращает слой доступа к SQL базе: gorm, sqlx, pgx или sqlc.
+// Для MongoDB, ClickHouse (своя реализация на clickhouse-go) и без БД - ""
+func dataAccess(config *ProjectConfig) string {
+	if !hasSQLMigrations(config) || strings.ToLower(config.Database) == "clickhouse" {
+		return ""
+	}
+	if config.DataAccess == "" {
+		return "gorm"
+	}
+	return strings.ToLower(config.DataAccess)
+}
+
+// isSQLite проверяет, что проект использует SQLite в памяти или в файле
+func isSQLite(config *ProjectConfig) bool {
+	database := strings.ToLower(config.Database)
+	return database == "in-memory" || database == "sqlite"
+}
+
+// sqliteTestDatabase возвращает конфигурацию БД для генерируемых тестов:
+// :memory: или файл во временном каталоге теста в режиме WAL
+func sqliteTestDatabase(config *ProjectConfig) string {
+	if strings.ToLower(config.Database) == "sqlite" {
+		return `config.DatabaseConfig{Type: "sqlite", Path: filepath.Join(t.TempDir(), "test.db"), JournalMode: "wal", BusyTimeout: 5000, MaxConnections: 4, MaxIdleConnections: 4}`
+	}
+	return `config.DatabaseConfig{Type: "sqlite", Path: ":memory:", MaxConnections: 1}`
+}
+
+// sqliteTestImport возвращает импорт path/filepath для sqliteTestDatabase с файлом
+func sqliteTestImport(config *ProjectConfig) string {
+	if strings.ToLower(config.Database) == "sqlite" {
+		return `
+	"path/filepath"`
+	}
+	return ""
+}
+
+// sqlBackend имена типов и драйвер database/sql выбранной SQL базы
+type sqlBackend struct {
+	Name         string
+	Receiver     string
+	Driver       string
+	DriverImport string
+	// Placeholder возвращает плейсхолдер n-го параметра запроса
+	Placeholder func(n int) string
+	// Returning поддерживает ли база INSERT ... RETURNING
+	Returning bool
+	// Now выражение текущего времени в SQL запросах
+	Now string
+	// SQLCEngine движок sqlc для разбора схемы и запросов
+	SQLCEngine string
+}
+
+// sqlBackendFor возвращает описание SQL базы из конфигурации проекта
+func sqlBackendFor(config *ProjectConfig) sqlBackend {
+	question := func(int) string { return "?" }
+
+	switch strings.ToLower(config.Database) {
+	case "mysql":
+		return sqlBackend{
+			Name:         "MySQL",
+			Receiver:     "m",
+			Driver:       "mysql",
+			DriverImport: "github.com/go-sql-driver/mysql",
+			Placeholder:  question,
+			Now:          "CURRENT_TIMESTAMP(3)",
+			SQLCEngine:   "mysql",
+		}
+	case "in-memory":
+		return sqlBackend{
+			Name:         "SQLite",
+			Receiver:     "s",
+			Driver:       "sqlite3",
+			DriverImport: "github.com/mattn/go-sqlite3",
+			Placeholder:  question,
+			Now:          "CURRENT_TIMESTAMP",
+			SQLCEngine:   "sqlite",
+		}
+	case "sqlite":
+		// Файл SQLite открывается драйвером без cgo, сервис собирается с CGO_ENABLED=0
+		return sqlBackend{
+			Name:         "SQLite",
+			Receiver:     "s",
+			Driver:       "sqlite",
+			DriverImport: "modernc.org/sqlite",
+			Placeholder:  question,
+			Now:          "CURRENT_TIMESTAMP",
+			SQLCEngine:   "sqlite",
+		}
+	case "cockroachdb":
+		// CockroachDB совместим с протоколом PostgreSQL и работает через те же драйверы
+		return sqlBackend{
+			Name:         "CockroachDB",
+			Receiver:     "c",
+			Driver:       "pgx",
+			DriverImport: "github.com/jackc/pgx/v5/stdlib",
+			Placeholder:  func(n int) string { return "$" + strconv.Itoa(n) },
+			Returning:    true,
+			Now:          "NOW()",
+			SQLCEngine:   "postgresql",
+		}
+	default:
+		return sqlBackend{
+			Name:         "PostgreSQL",
+			Receiver:     "p",
+			Driver:       "pgx",
+			DriverImport: "github.com/jackc/pgx/v5/stdlib",
+			Placeholder:  func(n int) string { return "$" + strconv.Itoa(n) },
+			Returning:    true,
+			Now:          "NOW()",
+			SQLCEngine:   "postgresql",
+		}
+	}
+}
+
+// sortedImports возвращает группу импортов в порядке gofmt
+func sortedImports(imports ...string) string {
+	sort.Slice(imports, func(i, j int) bool {
+		return strings.Trim(strings.TrimPrefix(imports[i], "_ "), `"`) < strings.Trim(strings.TrimPrefix(imports[j], "_ "), `"`)
+	})
+
+	var content string
+	for _, imp := range imports {
+		content += "\n\t" + imp
+	}
+	return content
+}
+
+// generateDatabaseSQLImplementation генерирует реализацию Database на database/sql: с sqlx или для запросов sqlc
+func (g *Generator) generateDatabaseSQLImplementation(config *ProjectConfig) string {
+	b := sqlBackendFor(config)
+	r := b.Receiver
+	sqlx := dataAccess(config) == "sqlx"
+
+	handle, txHandle, open, begin, raw, library := "*sql.DB", "*sql.Tx", "sql.Open", "BeginTx", r+".db", "database/sql"
+	imports := []string{`_ "` + b.DriverImport + `"`}
+	if sqlx {
+		handle, txHandle, open, begin, raw, library = "*sqlx.DB", "*sqlx.Tx", "sqlx.Open", "BeginTxx", r+".db.DB", "sqlx"
+		imports = append(imports, `"github.com/jmoiron/sqlx"`)
+	}
+
+	replicas := supportsReplicas(config)
+	fileSQLite := strings.ToLower(config.Database) == "sqlite"
+
+	content := `package database
+
+import (
+	"context"
+	"database/sql"`
+	if replicas {
+		content += `
+	"errors"`
+	}
+	content += `
+	"fmt"`
+	if fileSQLite {
+		content += `
+	"os"
+	"path/filepath"`
+	}
+	if b.Name != "SQLite" {
+		content += `
+	"time"`
+	}
+	content += `
+` + sortedImports(imports...) + `
+
+	"` + config.ModuleName + `/internal/config"
+)
+
+// ` + b.Name + `Database реализация для ` + b.Name + ` на ` + library + `
+type ` + b.Name + `Database struct {`
+	if replicas {
+		content += `
+	db ` + handle + `
+	// replicas реплики для чтения из database.replicas
+	replicas *replicaSet[` + handle + `]
+	config   *config.Config
+}
+`
+	} else {
+		content += `
+	db     ` + handle + `
+	config *config.Config
+}
+`
+	}
+	content += `
+// ` + b.Name + `Tx реализация транзакции для ` + b.Name + `
+type ` + b.Name + `Tx struct {
+	tx  ` + txHandle + `
+	ctx context.Context
+}
+`
+	if replicas {
+		content += `
+// New создает новое подключение к ` + b.Name + ` и его репликам для чтения
+func New(cfg *config.Config) (Database, error) {
+	db, err := open(cfg.Database)
+	if err != nil {
+		return nil, err
+	}
+
+	replicas, err := openReplicas(cfg.Database, sqlOps(open))
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &` + b.Name + `Database{
+		db:       db,
+		replicas: replicas,
+		config:   cfg,
+	}, nil
+}
+
+// open подключается к серверу ` + b.Name + ` из dbCfg: primary или реплике
+func open(dbCfg config.DatabaseConfig) (` + handle + `, error) {
+	db, err := ` + open + `("` + b.Driver + `", dbCfg.DSN())
+	if err != nil {
+		return nil, fmt.Errorf("ошибка подключения к ` + b.Name + ` %s: %w", dbCfg.Host, err)
+	}
+
+	// Настройка пула соединений
+	db.SetMaxOpenConns(dbCfg.MaxConnections)
+	db.SetMaxIdleConns(dbCfg.MaxIdleConnections)
+	db.SetConnMaxLifetime(time.Hour)
+
+	// Open не устанавливает соединение, проверяем доступность БД сразу
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("ошибка подключения к ` + b.Name + ` %s: %w", dbCfg.Host, err)
+	}
+
+	return db, nil
+}
+`
+	} else {
+		content += `
+// New создает новое подключение к ` + b.Name + `
+func New(cfg *config.Config) (Database, error) {`
+		if fileSQLite {
+			content += `
+	// Каталог файла БД создается при первом запуске
+	if err := os.MkdirAll(filepath.Dir(cfg.Database.Path), 0755); err != nil {
+		return nil, fmt.Errorf("ошибка создания каталога SQLite: %w", err)
+	}
+`
+		}
+		content += `
+	db, err := ` + open + `("` + b.Driver + `", cfg.GetDSN())
+	if err != nil {
+		return nil, fmt.Errorf("ошибка подключения к ` + b.Name + `: %w", err)
+	}
+`
+		if fileSQLite {
+			content += `
+	// В режиме WAL чтение идет параллельно, запись ждет блокировку не дольше busy_timeout
+	db.SetMaxOpenConns(cfg.Database.MaxConnections)
+	db.SetMaxIdleConns(cfg.Database.MaxIdleConnections)
+`
+		} else if b.Name == "SQLite" {
+			content += `
+	// Каждое соединение с :memory: - отдельная БД, поэтому пул ограничивается max_connections
+	if cfg.Database.MaxConnections > 0 {
+		db.SetMaxOpenConns(cfg.Database.MaxConnections)
+	}
+`
+		} else {
+			content += `
+	// Настройка пула соединений
+	db.SetMaxOpenConns(cfg.Database.MaxConnections)
+	db.SetMaxIdleConns(cfg.Database.MaxIdleConnections)
+	db.SetConnMaxLifetime(time.Hour)
+`
+		}
+		content += `
+	// Open не устанавливает соединение, проверяем доступность БД сразу
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("ошибка подключения к ` + b.Name + `: %w", err)
+	}
+
+	return &` + b.Name + `Database{
+		db:     db,
+		config: cfg,
+	}, nil
+}
+`
+	}
+
+	content += `
+// Connect подключается к БД
+func (` + r + ` *` + b.Name + `Database) Connect() error {
+	return ` + r + `.db.Ping()
+}
+
+// Close закрывает подключение
+func (` + r + ` *` + b.Name + `Database) Close() error {`
+	if replicas {
+		content += `
+	return errors.Join(` + r + `.db.Close(), ` + r + `.replicas.close())`
+	} else {
+		content += `
+	return ` + r + `.db.Close()`
+	}
+	content += `
+}
+
+// Ping проверяет подключение
+func (` + r + ` *` + b.Name + `Database) Ping() error {
+	return ` + r + `.db.Ping()
+}
+
+// BeginTx начинает транзакцию
+func (` + r + ` *` + b.Name + `Database) BeginTx(ctx context.Context) (Tx, error) {
+	tx, err := ` + r + `.db.` + begin + `(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &` + b.Name + `Tx{tx: tx}
+	t.ctx = contextWithTx(ctx, t)
+	return t, nil
+}
+
+` + withinTransactionMethod(config, r, b.Name) + `
+
+// Migrate применяет миграции из migrations/ до последней версии
+func (` + r + ` *` + b.Name + `Database) Migrate() error {
+	return MigrateCommand(context.Background(), ` + r + `, "up")
+}
+
+// sqlDB возвращает пул database/sql для миграций
+func (` + r + ` *` + b.Name + `Database) sqlDB() (*sql.DB, error) {
+	return ` + raw + `, nil
+}
+
+// Stats возвращает статистику
+func (` + r + ` *` + b.Name + `Database) Stats() Stats {`
+	if replicas {
+		content += `
+	stats := poolStats(` + r + `.db.Stats())
+	stats.Replicas = ` + r + `.replicas.stats()
+	return stats
+}
+
+// DB возвращает пул соединений primary
+func (` + r + ` *` + b.Name + `Database) DB() ` + handle + ` {
+	return ` + r + `.db
+}
+` + replicaMethods(r, b.Name, handle, r+".db")
+	} else {
+		content += `
+	stats := ` + r + `.db.Stats()
+	return Stats{
+		OpenConnections:  stats.OpenConnections,
+		InUseConnections: stats.InUse,
+		IdleConnections:  stats.Idle,
+	}
+}
+
+// DB возвращает пул соединений
+func (` + r + ` *` + b.Name + `Database) DB() ` + handle + ` {
+	return ` + r + `.db
+}
+`
+	}
+	content += `
+// Commit подтверждает транзакцию
+func (tx *` + b.Name + `Tx) Commit() error {
+	if err := tx.tx.Commit(); err != nil {
+		return err
+	}
+	runAfterCommit(tx.ctx)
+	return nil
+}
+
+// Rollback откатывает транзакцию
+func (tx *` + b.Name + `Tx) Rollback() error {
+	return tx.tx.Rollback()
+}
+
+// Context возвращает контекст транзакции
+func (tx *` + b.Name + `Tx) Context() context.Context {
+	return tx.ctx
+}
+
+// Tx возвращает транзакцию для запросов репозиториев
+func (tx *` + b.Name + `Tx) Tx() ` + txHandle + ` {
+	return tx.tx
+}
+
+// Savepoint создает точку сохранения для вложенного WithinTransaction
+func (tx *` + b.Name + `Tx) Savepoint(name string) error {
+	_, err := tx.tx.ExecContext(tx.ctx, "SAVEPOINT "+name)
+	return err
+}
+
+// RollbackTo откатывает транзакцию к точке сохранения
+func (tx *` + b.Name + `Tx) RollbackTo(name string) error {
+	_, err := tx.tx.ExecContext(tx.ctx, "ROLLBACK TO SAVEPOINT "+name)
+	return err
+}
+`
+	return content
+}
+
+// generatePgxImplementation генерирует реализацию PostgreSQL или CockroachDB на пуле pgx
+func (g *Generator) generatePgxImplementation(config *ProjectConfig) string {
+	b := sqlBackendFor(config)
+	r := b.Receiver
+	replicas := supportsReplicas(config)
+
+	content := `package database
+
+import (
+	"context"
+	"database/sql"`
+	if replicas {
+		content += `
+	"errors"`
+	}
+	content += `
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+
+	"` + config.ModuleName + `/internal/config"
+)
+
+// ` + b.Name + `Database реализация для ` + b.Name + ` на пуле pgx
+type ` + b.Name + `Database struct {
+	pool *pgxpool.Pool`
+	if replicas {
+		content += `
+	// replicas реплики для чтения из database.replicas
+	replicas *replicaSet[*pgxpool.Pool]`
+	}
+	content += `
+	// sql обертка database/sql над тем же пулом для goose
+	sql    *sql.DB
+	config *config.Config
+}
+
+// ` + b.Name + `Tx реализация транзакции для ` + b.Name + `
+type ` + b.Name + `Tx struct {
+	tx  pgx.Tx
+	ctx context.Context
+}
+`
+	if replicas {
+		content += `
+// New создает новый пул подключений к ` + b.Name + ` и пулы его реплик для чтения
+func New(cfg *config.Config) (Database, error) {
+	pool, err := open(cfg.Database)
+	if err != nil {
+		return nil, err
+	}
+
+	replicas, err := openReplicas(cfg.Database, pgxOps(open))
+	if err != nil {
+		pool.Close()
+		return nil, err
+	}
+
+	return &` + b.Name + `Database{
+		pool:     pool,
+		replicas: replicas,
+		sql:      stdlib.OpenDBFromPool(pool),
+		config:   cfg,
+	}, nil
+}
+`
+	} else {
+		content += `
+// New создает новый пул подключений к ` + b.Name + `
+func New(cfg *config.Config) (Database, error) {
+	pool, err := open(cfg.Database)
+	if err != nil {
+		return nil, err
+	}
+
+	return &` + b.Name + `Database{
+		pool:   pool,
+		sql:    stdlib.OpenDBFromPool(pool),
+		config: cfg,
+	}, nil
+}
+`
+	}
+	content += `
+// open создает пул подключений к серверу ` + b.Name + ` из dbCfg`
+	if replicas {
+		content += `: primary или реплике`
+	}
+	content += `
+func open(dbCfg config.DatabaseConfig) (*pgxpool.Pool, error) {
+	poolConfig, err := pgxpool.ParseConfig(dbCfg.DSN())
+	if err != nil {
+		return nil, fmt.Errorf("ошибка разбора DSN ` + b.Name + `: %w", err)
+	}
+
+	// Настройка пула соединений: max_idle_connections - соединения, которые пул держит открытыми
+	poolConfig.MaxConns = int32(dbCfg.MaxConnections)
+	poolConfig.MinConns = int32(dbCfg.MaxIdleConnections)
+	poolConfig.MaxConnLifetime = time.Hour
+
+	pool, err := pgxpool.NewWithConfig(context.Background(), poolConfig)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка подключения к ` + b.Name + ` %s: %w", dbCfg.Host, err)
+	}
+
+	if err := pool.Ping(context.Background()); err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("ошибка подключения к ` + b.Name + ` %s: %w", dbCfg.Host, err)
+	}
+
+	return pool, nil
+}
+
+// Connect подключается к БД
+func (` + r + ` *` + b.Name + `Database) Connect() error {
+	return ` + r + `.pool.Ping(context.Background())
+}
+`
+	if replicas {
+		content += `
+// Close закрывает пулы primary и реплик
+func (` + r + ` *` + b.Name + `Database) Close() error {
+	err := ` + r + `.sql.Close()
+	` + r + `.pool.Close()
+	return errors.Join(err, ` + r + `.replicas.close())
+}
+`
+	} else {
+		content += `
+// Close закрывает пул
+func (` + r + ` *` + b.Name + `Database) Close() error {
+	err := ` + r + `.sql.Close()
+	` + r + `.pool.Close()
+	return err
+}
+`
+	}
+	content += `
+// Ping проверяет подключение
+func (` + r + ` *` + b.Name + `Database) Ping() error {
+	return ` + r + `.pool.Ping(context.Background())
+}
+
+// BeginTx начинает транзакцию
+func (` + r + ` *` + b.Name + `Database) BeginTx(ctx context.Context) (Tx, error) {
+	tx, err := ` + r + `.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &` + b.Name + `Tx{tx: tx}
+	t.ctx = contextWithTx(ctx, t)
+	return t, nil
+}
+
+` + withinTransactionMethod(config, r, b.Name) + `
+
+// Migrate применяет миграции из migrations/ до последней версии
+func (` + r + ` *` + b.Name + `Database) Migrate() error {
+	return MigrateCommand(context.Background(), ` + r + `, "up")
+}
+
+// sqlDB возвращает database/sql поверх пула pgx для миграций
+func (` + r + ` *` + b.Name + `Database) sqlDB() (*sql.DB, error) {
+	return ` + r + `.sql, nil
+}
+`
+	if replicas {
+		content += `
+// Stats возвращает статистику
+func (` + r + ` *` + b.Name + `Database) Stats() Stats {
+	stats := pgxStats(` + r + `.pool)
+	stats.Replicas = ` + r + `.replicas.stats()
+	return stats
+}
+
+// Pool возвращает пул pgx primary
+func (` + r + ` *` + b.Name + `Database) Pool() *pgxpool.Pool {
+	return ` + r + `.pool
+}
+` + replicaMethods(r, b.Name, "*pgxpool.Pool", r+".pool")
+	} else {
+		content += `
+// Stats возвращает статистику
+func (` + r + ` *` + b.Name + `Database) Stats() Stats {
+	stats := ` + r + `.pool.Stat()
+	return Stats{
+		OpenConnections:  int(stats.TotalConns()),
+		InUseConnections: int(stats.AcquiredConns()),
+		IdleConnections:  int(stats.IdleConns()),
+	}
+}
+
+// Pool возвращает пул pgx
+func (` + r + ` *` + b.Name + `Database) Pool() *pgxpool.Pool {
+	return ` + r + `.pool
+}
+`
+	}
+	content += `
+// Commit подтверждает транзакцию
+func (tx *` + b.Name + `Tx) Commit() error {
+	if err := tx.tx.Commit(tx.ctx); err != nil {
+		return err
+	}
+	runAfterCommit(tx.ctx)
+	return nil
+}
+
+// Rollback откатывает транзакцию
+func (tx *` + b.Name + `Tx) Rollback() error {
+	return tx.tx.Rollback(tx.ctx)
+}
+
+// Context возвращает контекст транзакции
+func (tx *` + b.Name + `Tx) Context() context.Context {
+	return tx.ctx
+}
+
+// Tx возвращает транзакцию pgx для запросов репозиториев
+func (tx *` + b.Name + `Tx) Tx() pgx.Tx {
+	return tx.tx
+}
+
+// Savepoint создает точку сохранения для вложенного WithinTransaction
+func (tx *` + b.Name + `Tx) Savepoint(name string) error {
+	_, err := tx.tx.Exec(tx.ctx, "SAVEPOINT "+name)
+	return err
+}
+
+// RollbackTo откатывает транзакцию к точке сохранения
+func (tx *` + b.Name + `Tx) RollbackTo(name string) error {
+	_, err := tx.tx.Exec(tx.ctx, "ROLLBACK TO SAVEPOINT "+name)
+	return err
+}
+`
+	return content
+}
+
+// sqlxUserMethods методы UserRepositoryImpl на sqlx, запросы пишутся с ? и переводятся Rebind.
+// read - вызов, возвращающий контекст и подключение для чтения
+func sqlxUserMethods(b sqlBackend, read string) string {
+	content := `
+// userColumns колонки users, совпадающие с db тегами models.User
+const userColumns = "id, email, name, created_at, updated_at"
+
+// GetByID получает пользователя по ID
+func (r *UserRepositoryImpl) GetByID(id int64) (*models.User, error) {
+	ctx, db := ` + read + `
+	var user models.User
+	query := db.Rebind("SELECT " + userColumns + " FROM users WHERE id = ?")
+	if err := sqlx.GetContext(ctx, db, &user, query, id); err != nil {
+		return nil, database.TranslateError(err)
+	}
+	return &user, nil
+}
+
+// GetByEmail получает пользователя по email
+func (r *UserRepositoryImpl) GetByEmail(email string) (*models.User, error) {
+	ctx, db := ` + read + `
+	var user models.User
+	query := db.Rebind("SELECT " + userColumns + " FROM users WHERE email = ?")
+	if err := sqlx.GetContext(ctx, db, &user, query, email); err != nil {
+		return nil, database.TranslateError(err)
+	}
+	return &user, nil
+}
+
+// Create создает нового пользователя и заполняет его ID
+func (r *UserRepositoryImpl) Create(user *models.User) error {
+	ctx, db := r.conn()
+	now := time.Now().UTC()
+	user.CreatedAt, user.UpdatedAt = now, now
+`
+	if b.Returning {
+		content += `
+	query := db.Rebind("INSERT INTO users (email, name, created_at, updated_at) VALUES (?, ?, ?, ?) RETURNING id")
+	err := db.QueryRowxContext(ctx, query, user.Email, user.Name, user.CreatedAt, user.UpdatedAt).Scan(&user.ID)
+	return database.TranslateError(err)
+}
+`
+	} else {
+		content += `
+	query := db.Rebind("INSERT INTO users (email, name, created_at, updated_at) VALUES (?, ?, ?, ?)")
+	result, err := db.ExecContext(ctx, query, user.Email, user.Name, user.CreatedAt, user.UpdatedAt)
+	if err != nil {
+		return database.TranslateError(err)
+	}
+
+	user.ID, err = result.LastInsertId()
+	return err
+}
+`
+	}
+
+	content += `
+// Update обновляет email и имя пользователя
+func (r *UserRepositoryImpl) Update(user *models.User) error {
+	ctx, db := r.conn()
+	user.UpdatedAt = time.Now().UTC()
+	query := db.Rebind("UPDATE users SET email = ?, name = ?, updated_at = ? WHERE id = ?")
+	result, err := db.ExecContext(ctx, query, user.Email, user.Name, user.UpdatedAt, user.ID)
+	if err != nil {
+		return database.TranslateError(err)
+	}
+	return requireAffected(result)
+}
+
+// Delete удаляет пользователя
+func (r *UserRepositoryImpl) Delete(id int64) error {
+	ctx, db := r.conn()
+	result, err := db.ExecContext(ctx, db.Rebind("DELETE FROM users WHERE id = ?"), id)
+	if err != nil {
+		return database.TranslateError(err)
+	}
+	return requireAffected(result)
+}
+
+// List возвращает список пользователей
+func (r *UserRepositoryImpl) List(offset, limit int) ([]*models.User, error) {
+	ctx, db := ` + read + `
+	users := []*models.User{}
+	query := db.Rebind("SELECT " + userColumns + " FROM users ORDER BY id LIMIT ? OFFSET ?")
+	if err := sqlx.SelectContext(ctx, db, &users, query, limit, offset); err != nil {
+		return nil, database.TranslateError(err)
+	}
+	return users, nil
+}
+
+// requireAffected возвращает database.ErrNotFound, если запрос не изменил ни одной строки
+func requireAffected(result sql.Result) error {
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return database.ErrNotFound
+	}
+	return nil
+}
+`
+	return content
+}
+
+// pgxUserMethods методы UserRepositoryImpl на пуле pgx, read - вызов conn для чтения
+func pgxUserMethods(read string) string {
+	return `
+// querier общие методы *pgxpool.Pool и pgx.Tx
+type querier interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// userColumns колонки users в порядке scanUser
+const userColumns = "id, email, name, created_at, updated_at"
+
+// scanUser читает строку users в models.User
+func scanUser(row pgx.Row) (*models.User, error) {
+	var user models.User
+	if err := row.Scan(&user.ID, &user.Email, &user.Name, &user.CreatedAt, &user.UpdatedAt); err != nil {
+		return nil, database.TranslateError(err)
+	}
+	return &user, nil
+}
+
+// GetByID получает пользователя по ID
+func (r *UserRepositoryImpl) GetByID(id int64) (*models.User, error) {
+	ctx, db := ` + read + `
+	return scanUser(db.QueryRow(ctx, "SELECT "+userColumns+" FROM users WHERE id = $1", id))
+}
+
+// GetByEmail получает пользователя по email
+func (r *UserRepositoryImpl) GetByEmail(email string) (*models.User, error) {
+	ctx, db := ` + read + `
+	return scanUser(db.QueryRow(ctx, "SELECT "+userColumns+" FROM users WHERE email = $1", email))
+}
+
+// Create создает нового пользователя, ID и время создания заполняет БД
+func (r *UserRepositoryImpl) Create(user *models.User) error {
+	ctx, db := r.conn()
+	err := db.QueryRow(ctx,
+		"INSERT INTO users (email, name) VALUES ($1, $2) RETURNING id, created_at, updated_at",
+		user.Email, user.Name,
+	).Scan(&user.ID, &user.CreatedAt, &user.UpdatedAt)
+	return database.TranslateError(err)
+}
+
+// Update обновляет email и имя пользователя
+func (r *UserRepositoryImpl) Update(user *models.User) error {
+	ctx, db := r.conn()
+	err := db.QueryRow(ctx,
+		"UPDATE users SET email = $1, name = $2, updated_at = NOW() WHERE id = $3 RETURNING updated_at",
+		user.Email, user.Name, user.ID,
+	).Scan(&user.UpdatedAt)
+	return database.TranslateError(err)
+}
+
+// Delete удаляет пользователя
+func (r *UserRepositoryImpl) Delete(id int64) error {
+	ctx, db := r.conn()
+	tag, err := db.Exec(ctx, "DELETE FROM users WHERE id = $1", id)
+	if err != nil {
+		return database.TranslateError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return database.ErrNotFound
+	}
+	return nil
+}
+
+// List возвращает список пользователей
+func (r *UserRepositoryImpl) List(offset, limit int) ([]*models.User, error) {
+	ctx, db := ` + read + `
+	rows, err := db.Query(ctx, "SELECT "+userColumns+" FROM users ORDER BY id LIMIT $1 OFFSET $2", limit, offset)
+	if err != nil {
+		return nil, database.TranslateError(err)
+	}
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (*models.User, error) {
+		return scanUser(row)
+	})
+}
+`
+}
+
+// sqlcUserMethods методы UserRepositoryImpl поверх запросов sqlc из queries/users.sql, read - вызов conn для чтения
+func sqlcUserMethods(b sqlBackend, read string) string {
+	limit := "int32"
+	if b.Name == "SQLite" {
+		limit = "int64"
+	}
+
+	content := `
+// toUser переводит строку sqlc в модель
+func toUser(user queries.User) *models.User {
+	return &models.User{
+		ID:        user.ID,
+		Email:     user.Email,
+		Name:      user.Name,
+		CreatedAt: user.CreatedAt,
+		UpdatedAt: user.UpdatedAt,
+	}
+}
+
+// GetByID получает пользователя по ID
+func (r *UserRepositoryImpl) GetByID(id int64) (*models.User, error) {
+	ctx, q := ` + read + `
+	user, err := q.GetUser(ctx, id)
+	if err != nil {
+		return nil, database.TranslateError(err)
+	}
+	return toUser(user), nil
+}
+
+// GetByEmail получает пользователя по email
+func (r *UserRepositoryImpl) GetByEmail(email string) (*models.User, error) {
+	ctx, q := ` + read + `
+	user, err := q.GetUserByEmail(ctx, email)
+	if err != nil {
+		return nil, database.TranslateError(err)
+	}
+	return toUser(user), nil
+}
+
+// Create создает нового пользователя, ID и время создания заполняет БД
+func (r *UserRepositoryImpl) Create(user *models.User) error {
+	ctx, q := r.conn()
+`
+	if b.Returning {
+		content += `
+	created, err := q.CreateUser(ctx, queries.CreateUserParams{Email: user.Email, Name: user.Name})
+	if err != nil {
+		return database.TranslateError(err)
+	}
+`
+	} else {
+		content += `
+	result, err := q.CreateUser(ctx, queries.CreateUserParams{Email: user.Email, Name: user.Name})
+	if err != nil {
+		return database.TranslateError(err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	created, err := q.GetUser(ctx, id)
+	if err != nil {
+		return database.TranslateError(err)
+	}
+`
+	}
+
+	content += `
+	*user = *toUser(created)
+	return nil
+}
+
+// Update обновляет email и имя пользователя
+func (r *UserRepositoryImpl) Update(user *models.User) error {
+	ctx, q := r.conn()
+	rows, err := q.UpdateUser(ctx, queries.UpdateUserParams{
+		Email: user.Email,
+		Name:  user.Name,
+		ID:    user.ID,
+	})
+	if err != nil {
+		return database.TranslateError(err)
+	}
+	if rows == 0 {
+		return database.ErrNotFound
+	}
+	return nil
+}
+
+// Delete удаляет пользователя
+func (r *UserRepositoryImpl) Delete(id int64) error {
+	ctx, q := r.conn()
+	rows, err := q.DeleteUser(ctx, id)
+	if err != nil {
+		return database.TranslateError(err)
+	}
+	if rows == 0 {
+		return database.ErrNotFound
+	}
+	return nil
+}
+
+// List возвращает список пользователей
+func (r *UserRepositoryImpl) List(offset, limit int) ([]*models.User, error) {
+	ctx, q := ` + read + `
+	rows, err := q.ListUsers(ctx, queries.ListUsersParams{
+		Limit:  ` + limit + `(limit),
+		Offset: ` + limit + `(offset),
+	})
+	if err != nil {
+		return nil, database.TranslateError(err)
+	}
+
+	users := make([]*models.User, 0, len(rows))
+	for _, row := range rows {
+		users = append(users, toUser(row))
+	}
+	return users, nil
+}
+`
+	return content
+}
+
+// generateSQLC создает sqlc.yaml, queries/users.sql и сгенерированный по ним пакет internal/repository/queries
+func (g *Generator) generateSQLC(config *ProjectConfig) error {
+	b := sqlBackendFor(config)
+
+	for _, dir := range []string{"queries", "internal/repository/queries"} {
+		if err := os.MkdirAll(filepath.Join(g.projectPath, dir), 0755); err != nil {
+			return err
+		}
+	}
+
+	queries := sqlcUserQueries(b)
+	files := map[string]string{
+		"sqlc.yaml":                                g.generateSQLCConfig(b),
+		"queries/users.sql":                        sqlcQueriesFile(queries),
+		"internal/repository/queries/db.go":        g.generateSQLCDB(),
+		"internal/repository/queries/models.go":    g.generateSQLCModels(),
+		"internal/repository/queries/users.sql.go": g.generateSQLCUsers(b, queries),
+	}
+
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(g.projectPath, name), []byte(content), 0644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// generateSQLCConfig генерирует sqlc.yaml: схема читается из goose миграций
+func (g *Generator) generateSQLCConfig(b sqlBackend) string {
+	return `# Генерация кода запросов: make sqlc
+version: "2"
+sql:
+  - engine: "` + b.SQLCEngine + `"
+    schema: "migrations"
+    queries: "queries"
+    gen:
+      go:
+        package: "queries"
+        out: "internal/repository/queries"
+`
+}
+
+// sqlcQuery запрос из queries/users.sql
+type sqlcQuery struct {
+	Name string
+	Kind string
+	SQL  string
+}
+
+// sqlcUserQueries запросы к users в синтаксисе выбранной базы
+func sqlcUserQueries(b sqlBackend) []sqlcQuery {
+	p := b.Placeholder
+
+	create := sqlcQuery{Name: "CreateUser", Kind: ":execresult", SQL: "INSERT INTO users (email, name)\nVALUES (" + p(1) + ", " + p(2) + ")"}
+	if b.Returning {
+		create = sqlcQuery{Name: "CreateUser", Kind: ":one", SQL: create.SQL + "\nRETURNING *"}
+	}
+
+	return []sqlcQuery{
+		create,
+		{Name: "DeleteUser", Kind: ":execrows", SQL: "DELETE FROM users\nWHERE id = " + p(1)},
+		{Name: "GetUser", Kind: ":one", SQL: "SELECT * FROM users\nWHERE id = " + p(1)},
+		{Name: "GetUserByEmail", Kind: ":one", SQL: "SELECT * FROM users\nWHERE email = " + p(1)},
+		{Name: "ListUsers", Kind: ":many", SQL: "SELECT * FROM users\nORDER BY id\nLIMIT " + p(1) + " OFFSET " + p(2)},
+		{Name: "UpdateUser", Kind: ":execrows", SQL: "UPDATE users\nSET email = " + p(1) + ", name = " + p(2) + ", updated_at = " + b.Now + "\nWHERE id = " + p(3)},
+	}
+}
+
+// sqlcQueriesFile генерирует queries/users.sql
+func sqlcQueriesFile(queries []sqlcQuery) string {
+	var content string
+	for i, q := range queries {
+		if i > 0 {
+			content += "\n"
+		}
+		content += "-- name: " + q.Name + " " + q.Kind + "\n" + q.SQL + ";\n"
+	}
+	return content
+}
+
+// sqlcHeader заголовок файлов, сгенерированных sqlc
+const sqlcHeader = `// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.25.0
+
+package queries
+`
+
+// generateSQLCDB генерирует queries/db.go в том виде, в котором его создает sqlc
+func (g *Generator) generateSQLCDB() string {
+	return sqlcHeader + `
+import (
+	"context"
+	"database/sql"
+)
+
+type DBTX interface {
+	ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
+	PrepareContext(context.Context, string) (*sql.Stmt, error)
+	QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error)
+	QueryRowContext(context.Context, string, ...interface{}) *sql.Row
+}
+
+func New(db DBTX) *Queries {
+	return &Queries{db: db}
+}
+
+type Queries struct {
+	db DBTX
+}
+
+func (q *Queries) WithTx(tx *sql.Tx) *Queries {
+	return &Queries{
+		db: tx,
+	}
+}
+`
+}
+
+// generateSQLCModels генерирует queries/models.go по таблицам из migrations/
+func (g *Generator) generateSQLCModels() string {
+	return sqlcHeader + `
+import (
+	"time"
+)
+
+type Product struct {
+	ID          int64
+	Name        string
+	Description string
+	Price       float64
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type User struct {
+	ID        int64
+	Email     string
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+`
+}
+
+// generateSQLCUsers генерирует queries/users.sql.go по запросам из queries/users.sql
+func (g *Generator) generateSQLCUsers(b sqlBackend, queries []sqlcQuery) string {
+	limit := "int32"
+	if b.Name == "SQLite" {
+		limit = "int64"
+	}
+
+	scan := `
+		&i.ID,
+		&i.Email,
+		&i.Name,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	`
+
+	content := sqlcHeader + `
+import (
+	"context"`
+	if !b.Returning {
+		content += `
+	"database/sql"`
+	}
+	content += `
+)
+`
+
+	for _, q := range queries {
+		const columns = "id, email, name, created_at, updated_at"
+		text := strings.Replace(q.SQL, "*", columns, 1)
+		constName := strings.ToLower(q.Name[:1]) + q.Name[1:]
+
+		content += `
+const ` + constName + ` = ` + "`" + `-- name: ` + q.Name + ` ` + q.Kind + `
+` + text + `
+` + "`" + `
+`
+
+		switch q.Name {
+		case "CreateUser":
+			content += `
+type CreateUserParams struct {
+	Email string
+	Name  string
+}
+`
+			if q.Kind == ":one" {
+				content += `
+func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, error) {
+	row := q.db.QueryRowContext(ctx, createUser, arg.Email, arg.Name)
+	var i User
+	err := row.Scan(` + scan + `)
+	return i, err
+}
+`
+			} else {
+				content += `
+func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, createUser, arg.Email, arg.Name)
+}
+`
+			}
+		case "DeleteUser":
+			content += `
+func (q *Queries) DeleteUser(ctx context.Context, id int64) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteUser, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+`
+		case "GetUser", "GetUserByEmail":
+			arg := "id int64"
+			if q.Name == "GetUserByEmail" {
+				arg = "email string"
+			}
+			content += `
+func (q *Queries) ` + q.Name + `(ctx context.Context, ` + arg + `) (User, error) {
+	row := q.db.QueryRowContext(ctx, ` + constName + `, ` + strings.Fields(arg)[0] + `)
+	var i User
+	err := row.Scan(` + scan + `)
+	return i, err
+}
+`
+		case "ListUsers":
+			content += `
+type ListUsersParams struct {
+	Limit  ` + limit + `
+	Offset ` + limit + `
+}
+
+func (q *Queries) ListUsers(ctx context.Context, arg ListUsersParams) ([]User, error) {
+	rows, err := q.db.QueryContext(ctx, listUsers, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []User
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(` + strings.ReplaceAll(scan, "\n\t", "\n\t\t") + `); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+`
+		case "UpdateUser":
+			content += `
+type UpdateUserParams struct {
+	Email string
+	Name  string
+	ID    int64
+}
+
+func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateUser, arg.Email, arg.Name, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+`
+		}
+	}
+
+	return content
+}
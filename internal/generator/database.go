@@ -24,6 +24,16 @@ func (g *Generator) generateDatabaseLayer(config *ProjectConfig) error {
 		return err
 	}
 
+	// Создаем WithinTransaction
+	if err := g.generateTransactions(config); err != nil {
+		return err
+	}
+
+	// Создаем sticky primary, datasources и реплики для чтения
+	if err := g.generateReplicas(config); err != nil {
+		return err
+	}
+
 	// Создаем репозитории
 	if err := g.generateRepositories(config); err != nil {
 		return err
@@ -34,6 +44,18 @@ func (g *Generator) generateDatabaseLayer(config *ProjectConfig) error {
 		return err
 	}
 
+	// Создаем миграции схемы
+	if err := g.generateMigrations(config); err != nil {
+		return err
+	}
+
+	// Создаем запросы sqlc
+	if dataAccess(config) == "sqlc" {
+		if err := g.generateSQLC(config); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -43,7 +65,6 @@ func (g *Generator) generateDatabaseInterface(config *ProjectConfig) error {
 
 import (
 	"context"
-	"time"
 
 	appcontext "%s/pkg/context"
 )
@@ -57,6 +78,7 @@ type Database interface {
 
 	// Транзакции
 	BeginTx(ctx context.Context) (Tx, error)
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
 
 	// Миграции
 	Migrate() error
@@ -65,18 +87,34 @@ type Database interface {
 	Stats() Stats
 }
 
-// Tx интерфейс для транзакций
+// Tx интерфейс для транзакций.
+// Context() возвращает контекст с транзакцией, запросы репозиториев с ним выполняются в ней
 type Tx interface {
 	Commit() error
 	Rollback() error
 	Context() context.Context
 }
 
-// Stats статистика подключений к БД
+// Stats статистика подключений к БД, Replicas - по каждой реплике для чтения
 type Stats struct {
-	OpenConnections int
+	OpenConnections  int
+	InUseConnections int
+	IdleConnections  int
+	Replicas         []ReplicaStats
+}
+
+// ReplicaStats статистика подключений к реплике
+type ReplicaStats struct {
+	Name             string
+	OpenConnections  int
 	InUseConnections int
-	IdleConnections int
+	IdleConnections  int
+}
+
+// ReplicaSet реализуют Database, которые читают из реплик database.replicas
+type ReplicaSet interface {
+	ReplicaNames() []string
+	PingReplica(ctx context.Context, name string) error
 }
 
 // Repository базовый интерфейс для репозиториев
@@ -130,14 +168,25 @@ func (r *BaseRepository) Logger() interface{} {
 func (g *Generator) generateDatabaseImplementation(config *ProjectConfig) error {
 	var content string
 
-	switch strings.ToLower(config.Database) {
-	case "postgresql":
+	access := dataAccess(config)
+	database := strings.ToLower(config.Database)
+
+	switch {
+	case access == "sqlx" || access == "sqlc":
+		content = g.generateDatabaseSQLImplementation(config)
+	case access == "pgx":
+		content = g.generatePgxImplementation(config)
+	case database == "postgresql":
 		content = g.generatePostgreSQLImplementation(config)
-	case "mysql":
+	case database == "mysql":
 		content = g.generateMySQLImplementation(config)
-	case "mongodb":
+	case database == "mongodb":
 		content = g.generateMongoDBImplementation(config)
-	case "in-memory":
+	case database == "cockroachdb":
+		content = g.generateCockroachDBImplementation(config)
+	case database == "clickhouse":
+		content = g.generateClickHouseImplementation(config)
+	case database == "in-memory" || database == "sqlite":
 		content = g.generateSQLiteImplementation(config)
 	default:
 		content = g.generatePostgreSQLImplementation(config) // По умолчанию PostgreSQL
@@ -153,20 +202,24 @@ func (g *Generator) generatePostgreSQLImplementation(config *ProjectConfig) stri
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"time"
 
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
-	"gorm.io/gorm/logger"
+	"gorm.io/gorm/logger"`+gormTracingImport(config)+`
 
 	"%s/internal/config"
 )
 
 // PostgreSQLDatabase реализация для PostgreSQL
 type PostgreSQLDatabase struct {
-	db     *gorm.DB
-	config *config.Config
+	db *gorm.DB
+	// replicas реплики для чтения из database.replicas
+	replicas *replicaSet[*gorm.DB]
+	config   *config.Config
 }
 
 // PostgreSQLTx реализация транзакции для PostgreSQL
@@ -175,37 +228,58 @@ type PostgreSQLTx struct {
 	ctx context.Context
 }
 
-// New создает новое подключение к PostgreSQL
+// New создает новое подключение к PostgreSQL и его репликам для чтения
 func New(cfg *config.Config) (Database, error) {
-	dsn := cfg.GetDSN()
-	
+	db, err := open(cfg, cfg.Database)
+	if err != nil {
+		return nil, err
+	}
+
+	replicas, err := openReplicas(cfg.Database, gormOps(func(dbCfg config.DatabaseConfig) (*gorm.DB, error) {
+		return open(cfg, dbCfg)
+	}))
+	if err != nil {
+		if sqlDB, dbErr := db.DB(); dbErr == nil {
+			_ = sqlDB.Close()
+		}
+		return nil, err
+	}
+
+	return &PostgreSQLDatabase{
+		db:       db,
+		replicas: replicas,
+		config:   cfg,
+	}, nil
+}
+
+// open подключается к серверу PostgreSQL из dbCfg: primary или реплике
+func open(cfg *config.Config, dbCfg config.DatabaseConfig) (*gorm.DB, error) {
+	// TranslateError приводит ошибки уникальности драйвера к gorm.ErrDuplicatedKey
 	gormConfig := &gorm.Config{
-		Logger: logger.Default.LogMode(logger.Info),
+		Logger:         logger.Default.LogMode(logger.Info),
+		TranslateError: true,
 	}
 
 	if !cfg.App.Debug {
 		gormConfig.Logger = logger.Default.LogMode(logger.Silent)
 	}
 
-	db, err := gorm.Open(postgres.Open(dsn), gormConfig)
+	db, err := gorm.Open(postgres.Open(dbCfg.DSN()), gormConfig)
 	if err != nil {
-		return nil, fmt.Errorf("ошибка подключения к PostgreSQL: %%w", err)
+		return nil, fmt.Errorf("ошибка подключения к PostgreSQL %%s: %%w", dbCfg.Host, err)
 	}
-
+`+gormTracingSetup(config)+`
 	sqlDB, err := db.DB()
 	if err != nil {
 		return nil, fmt.Errorf("ошибка получения sql.DB: %%w", err)
 	}
 
 	// Настройка пула соединений
-	sqlDB.SetMaxOpenConns(cfg.Database.MaxConnections)
-	sqlDB.SetMaxIdleConns(cfg.Database.MaxIdleConnections)
+	sqlDB.SetMaxOpenConns(dbCfg.MaxConnections)
+	sqlDB.SetMaxIdleConns(dbCfg.MaxIdleConnections)
 	sqlDB.SetConnMaxLifetime(time.Hour)
 
-	return &PostgreSQLDatabase{
-		db:     db,
-		config: cfg,
-	}, nil
+	return db, nil
 }
 
 // Connect подключается к БД
@@ -217,13 +291,13 @@ func (p *PostgreSQLDatabase) Connect() error {
 	return sqlDB.Ping()
 }
 
-// Close закрывает подключение
+// Close закрывает подключения к primary и репликам
 func (p *PostgreSQLDatabase) Close() error {
 	sqlDB, err := p.db.DB()
 	if err != nil {
 		return err
 	}
-	return sqlDB.Close()
+	return errors.Join(sqlDB.Close(), p.replicas.close())
 }
 
 // Ping проверяет подключение
@@ -242,17 +316,24 @@ func (p *PostgreSQLDatabase) BeginTx(ctx context.Context) (Tx, error) {
 		return nil, tx.Error
 	}
 
-	return &PostgreSQLTx{
-		tx:  tx,
-		ctx: ctx,
-	}, nil
+	t := &PostgreSQLTx{tx: tx}
+	t.ctx = contextWithTx(ctx, t)
+	return t, nil
+}
+
+// WithinTransaction выполняет fn в транзакции, репозитории берут ее из контекста fn
+func (p *PostgreSQLDatabase) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return withinTransaction(ctx, p, fn)
 }
 
-// Migrate выполняет миграции
+// Migrate применяет миграции из migrations/ до последней версии
 func (p *PostgreSQLDatabase) Migrate() error {
-	// TODO: Добавить модели для миграции
-	// return p.db.AutoMigrate(&User{}, &Product{})
-	return nil
+	return MigrateCommand(context.Background(), p, "up")
+}
+
+// sqlDB возвращает пул database/sql для миграций
+func (p *PostgreSQLDatabase) sqlDB() (*sql.DB, error) {
+	return p.db.DB()
 }
 
 // Stats возвращает статистику
@@ -262,22 +343,23 @@ func (p *PostgreSQLDatabase) Stats() Stats {
 		return Stats{}
 	}
 
-	stats := sqlDB.Stats()
-	return Stats{
-		OpenConnections:  stats.OpenConnections,
-		InUseConnections: stats.InUse,
-		IdleConnections:  stats.Idle,
-	}
+	stats := poolStats(sqlDB.Stats())
+	stats.Replicas = p.replicas.stats()
+	return stats
 }
 
-// DB возвращает GORM DB
+// DB возвращает GORM DB primary
 func (p *PostgreSQLDatabase) DB() *gorm.DB {
 	return p.db
 }
-
+`+replicaMethods("p", "PostgreSQL", "*gorm.DB", "p.db")+`
 // Commit подтверждает транзакцию
 func (tx *PostgreSQLTx) Commit() error {
-	return tx.tx.Commit().Error
+	if err := tx.tx.Commit().Error; err != nil {
+		return err
+	}
+	runAfterCommit(tx.ctx)
+	return nil
 }
 
 // Rollback откатывает транзакцию
@@ -289,6 +371,21 @@ func (tx *PostgreSQLTx) Rollback() error {
 func (tx *PostgreSQLTx) Context() context.Context {
 	return tx.ctx
 }
+
+// DB возвращает транзакцию GORM для запросов репозиториев
+func (tx *PostgreSQLTx) DB() *gorm.DB {
+	return tx.tx
+}
+
+// Savepoint создает точку сохранения для вложенного WithinTransaction
+func (tx *PostgreSQLTx) Savepoint(name string) error {
+	return tx.tx.SavePoint(name).Error
+}
+
+// RollbackTo откатывает транзакцию к точке сохранения
+func (tx *PostgreSQLTx) RollbackTo(name string) error {
+	return tx.tx.RollbackTo(name).Error
+}
 `, config.ModuleName)
 }
 
@@ -298,20 +395,24 @@ func (g *Generator) generateMySQLImplementation(config *ProjectConfig) string {
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"time"
 
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
-	"gorm.io/gorm/logger"
+	"gorm.io/gorm/logger"`+gormTracingImport(config)+`
 
 	"%s/internal/config"
 )
 
 // MySQLDatabase реализация для MySQL
 type MySQLDatabase struct {
-	db     *gorm.DB
-	config *config.Config
+	db *gorm.DB
+	// replicas реплики для чтения из database.replicas
+	replicas *replicaSet[*gorm.DB]
+	config   *config.Config
 }
 
 // MySQLTx реализация транзакции для MySQL
@@ -320,37 +421,58 @@ type MySQLTx struct {
 	ctx context.Context
 }
 
-// New создает новое подключение к MySQL
+// New создает новое подключение к MySQL и его репликам для чтения
 func New(cfg *config.Config) (Database, error) {
-	dsn := cfg.GetDSN()
-	
+	db, err := open(cfg, cfg.Database)
+	if err != nil {
+		return nil, err
+	}
+
+	replicas, err := openReplicas(cfg.Database, gormOps(func(dbCfg config.DatabaseConfig) (*gorm.DB, error) {
+		return open(cfg, dbCfg)
+	}))
+	if err != nil {
+		if sqlDB, dbErr := db.DB(); dbErr == nil {
+			_ = sqlDB.Close()
+		}
+		return nil, err
+	}
+
+	return &MySQLDatabase{
+		db:       db,
+		replicas: replicas,
+		config:   cfg,
+	}, nil
+}
+
+// open подключается к серверу MySQL из dbCfg: primary или реплике
+func open(cfg *config.Config, dbCfg config.DatabaseConfig) (*gorm.DB, error) {
+	// TranslateError приводит ошибки уникальности драйвера к gorm.ErrDuplicatedKey
 	gormConfig := &gorm.Config{
-		Logger: logger.Default.LogMode(logger.Info),
+		Logger:         logger.Default.LogMode(logger.Info),
+		TranslateError: true,
 	}
 
 	if !cfg.App.Debug {
 		gormConfig.Logger = logger.Default.LogMode(logger.Silent)
 	}
 
-	db, err := gorm.Open(mysql.Open(dsn), gormConfig)
+	db, err := gorm.Open(mysql.Open(dbCfg.DSN()), gormConfig)
 	if err != nil {
-		return nil, fmt.Errorf("ошибка подключения к MySQL: %%w", err)
+		return nil, fmt.Errorf("ошибка подключения к MySQL %%s: %%w", dbCfg.Host, err)
 	}
-
+`+gormTracingSetup(config)+`
 	sqlDB, err := db.DB()
 	if err != nil {
 		return nil, fmt.Errorf("ошибка получения sql.DB: %%w", err)
 	}
 
 	// Настройка пула соединений
-	sqlDB.SetMaxOpenConns(cfg.Database.MaxConnections)
-	sqlDB.SetMaxIdleConns(cfg.Database.MaxIdleConnections)
+	sqlDB.SetMaxOpenConns(dbCfg.MaxConnections)
+	sqlDB.SetMaxIdleConns(dbCfg.MaxIdleConnections)
 	sqlDB.SetConnMaxLifetime(time.Hour)
 
-	return &MySQLDatabase{
-		db:     db,
-		config: cfg,
-	}, nil
+	return db, nil
 }
 
 // Connect подключается к БД
@@ -362,13 +484,13 @@ func (m *MySQLDatabase) Connect() error {
 	return sqlDB.Ping()
 }
 
-// Close закрывает подключение
+// Close закрывает подключения к primary и репликам
 func (m *MySQLDatabase) Close() error {
 	sqlDB, err := m.db.DB()
 	if err != nil {
 		return err
 	}
-	return sqlDB.Close()
+	return errors.Join(sqlDB.Close(), m.replicas.close())
 }
 
 // Ping проверяет подключение
@@ -387,16 +509,24 @@ func (m *MySQLDatabase) BeginTx(ctx context.Context) (Tx, error) {
 		return nil, tx.Error
 	}
 
-	return &MySQLTx{
-		tx:  tx,
-		ctx: ctx,
-	}, nil
+	t := &MySQLTx{tx: tx}
+	t.ctx = contextWithTx(ctx, t)
+	return t, nil
 }
 
-// Migrate выполняет миграции
+// WithinTransaction выполняет fn в транзакции, репозитории берут ее из контекста fn
+func (m *MySQLDatabase) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return withinTransaction(ctx, m, fn)
+}
+
+// Migrate применяет миграции из migrations/ до последней версии
 func (m *MySQLDatabase) Migrate() error {
-	// TODO: Добавить модели для миграции
-	return nil
+	return MigrateCommand(context.Background(), m, "up")
+}
+
+// sqlDB возвращает пул database/sql для миграций
+func (m *MySQLDatabase) sqlDB() (*sql.DB, error) {
+	return m.db.DB()
 }
 
 // Stats возвращает статистику
@@ -406,22 +536,23 @@ func (m *MySQLDatabase) Stats() Stats {
 		return Stats{}
 	}
 
-	stats := sqlDB.Stats()
-	return Stats{
-		OpenConnections:  stats.OpenConnections,
-		InUseConnections: stats.InUse,
-		IdleConnections:  stats.Idle,
-	}
+	stats := poolStats(sqlDB.Stats())
+	stats.Replicas = m.replicas.stats()
+	return stats
 }
 
-// DB возвращает GORM DB
+// DB возвращает GORM DB primary
 func (m *MySQLDatabase) DB() *gorm.DB {
 	return m.db
 }
-
+`+replicaMethods("m", "MySQL", "*gorm.DB", "m.db")+`
 // Commit подтверждает транзакцию
 func (tx *MySQLTx) Commit() error {
-	return tx.tx.Commit().Error
+	if err := tx.tx.Commit().Error; err != nil {
+		return err
+	}
+	runAfterCommit(tx.ctx)
+	return nil
 }
 
 // Rollback откатывает транзакцию
@@ -433,6 +564,21 @@ func (tx *MySQLTx) Rollback() error {
 func (tx *MySQLTx) Context() context.Context {
 	return tx.ctx
 }
+
+// DB возвращает транзакцию GORM для запросов репозиториев
+func (tx *MySQLTx) DB() *gorm.DB {
+	return tx.tx
+}
+
+// Savepoint создает точку сохранения для вложенного WithinTransaction
+func (tx *MySQLTx) Savepoint(name string) error {
+	return tx.tx.SavePoint(name).Error
+}
+
+// RollbackTo откатывает транзакцию к точке сохранения
+func (tx *MySQLTx) RollbackTo(name string) error {
+	return tx.tx.RollbackTo(name).Error
+}
 `, config.ModuleName)
 }
 
@@ -447,6 +593,7 @@ import (
 
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readpref"`+mongoTracingImport(config)+`
 
 	"%s/internal/config"
 )
@@ -469,7 +616,21 @@ func New(cfg *config.Config) (Database, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(cfg.Database.Timeout)*time.Second)
 	defer cancel()
 
-	client, err := mongo.Connect(ctx, options.Client().ApplyURI(cfg.Database.URI))
+	clientOptions := options.Client().ApplyURI(cfg.Database.URI)`+mongoTracingSetup(config)+`
+	// Чтения без транзакции и без записи в том же запросе идут по database.read_preference
+	if cfg.Database.ReadPreference != "" {
+		mode, err := readpref.ModeFromString(cfg.Database.ReadPreference)
+		if err != nil {
+			return nil, fmt.Errorf("database.read_preference: %%w", err)
+		}
+		readPref, err := readpref.New(mode)
+		if err != nil {
+			return nil, fmt.Errorf("database.read_preference: %%w", err)
+		}
+		clientOptions.SetReadPreference(readPref)
+	}
+
+	client, err := mongo.Connect(ctx, clientOptions)
 	if err != nil {
 		return nil, fmt.Errorf("ошибка подключения к MongoDB: %%w", err)
 	}
@@ -520,16 +681,20 @@ func (m *MongoDatabase) BeginTx(ctx context.Context) (Tx, error) {
 		return nil, err
 	}
 
-	return &MongoTx{
-		session: session,
-		ctx:     ctx,
-	}, nil
+	// Операции с контекстом сессии выполняются в транзакции
+	t := &MongoTx{session: session}
+	t.ctx = contextWithTx(mongo.NewSessionContext(ctx, session), t)
+	return t, nil
+}
+
+// WithinTransaction выполняет fn в транзакции, репозитории берут ее из контекста fn
+func (m *MongoDatabase) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return withinTransaction(ctx, m, fn)
 }
 
-// Migrate выполняет миграции (создание индексов)
+// Migrate применяет схему коллекций и версионные миграции из migrate.go
 func (m *MongoDatabase) Migrate() error {
-	// TODO: Создать индексы
-	return nil
+	return MigrateCommand(context.Background(), m, "up")
 }
 
 // Stats возвращает статистику
@@ -551,7 +716,11 @@ func (m *MongoDatabase) Client() *mongo.Client {
 // Commit подтверждает транзакцию
 func (tx *MongoTx) Commit() error {
 	defer tx.session.EndSession(tx.ctx)
-	return tx.session.CommitTransaction(tx.ctx)
+	if err := tx.session.CommitTransaction(tx.ctx); err != nil {
+		return err
+	}
+	runAfterCommit(tx.ctx)
+	return nil
 }
 
 // Rollback откатывает транзакцию
@@ -567,17 +736,43 @@ func (tx *MongoTx) Context() context.Context {
 `, config.ModuleName)
 }
 
-// generateSQLiteImplementation генерирует реализацию для SQLite (in-memory)
+// generateSQLiteImplementation генерирует реализацию для SQLite: в памяти (in-memory) или в файле (sqlite)
 func (g *Generator) generateSQLiteImplementation(config *ProjectConfig) string {
+	driver, std, dataDir := `"gorm.io/driver/sqlite"`, "", ""
+	pool := `
+	// Каждое соединение с :memory: - отдельная БД, поэтому пул ограничивается max_connections
+	if cfg.Database.MaxConnections > 0 {
+		sqlDB.SetMaxOpenConns(cfg.Database.MaxConnections)
+	}
+`
+	// Файл открывается чистым Go драйвером, поэтому сервис собирается с CGO_ENABLED=0
+	if strings.ToLower(config.Database) == "sqlite" {
+		driver, std = `"github.com/glebarez/sqlite"`, `
+	"os"
+	"path/filepath"`
+		dataDir = `
+	// Каталог файла БД создается при первом запуске
+	if err := os.MkdirAll(filepath.Dir(cfg.Database.Path), 0755); err != nil {
+		return nil, fmt.Errorf("ошибка создания каталога SQLite: %%w", err)
+	}
+`
+		pool = `
+	// В режиме WAL чтение идет параллельно, запись ждет блокировку не дольше busy_timeout
+	sqlDB.SetMaxOpenConns(cfg.Database.MaxConnections)
+	sqlDB.SetMaxIdleConns(cfg.Database.MaxIdleConnections)
+`
+	}
+
 	return fmt.Sprintf(`package database
 
 import (
 	"context"
-	"fmt"
+	"database/sql"
+	"fmt"`+std+`
 
-	"gorm.io/driver/sqlite"
+	`+driver+`
 	"gorm.io/gorm"
-	"gorm.io/gorm/logger"
+	"gorm.io/gorm/logger"`+gormTracingImport(config)+`
 
 	"%s/internal/config"
 )
@@ -597,9 +792,11 @@ type SQLiteTx struct {
 // New создает новое подключение к SQLite
 func New(cfg *config.Config) (Database, error) {
 	dsn := cfg.GetDSN()
-	
+	`+dataDir+`
+	// TranslateError приводит ошибки уникальности драйвера к gorm.ErrDuplicatedKey
 	gormConfig := &gorm.Config{
-		Logger: logger.Default.LogMode(logger.Info),
+		Logger:         logger.Default.LogMode(logger.Info),
+		TranslateError: true,
 	}
 
 	if !cfg.App.Debug {
@@ -610,7 +807,12 @@ func New(cfg *config.Config) (Database, error) {
 	if err != nil {
 		return nil, fmt.Errorf("ошибка подключения к SQLite: %%w", err)
 	}
-
+`+gormTracingSetup(config)+`
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, fmt.Errorf("ошибка получения sql.DB: %%w", err)
+	}
+`+pool+`
 	return &SQLiteDatabase{
 		db:     db,
 		config: cfg,
@@ -651,16 +853,24 @@ func (s *SQLiteDatabase) BeginTx(ctx context.Context) (Tx, error) {
 		return nil, tx.Error
 	}
 
-	return &SQLiteTx{
-		tx:  tx,
-		ctx: ctx,
-	}, nil
+	t := &SQLiteTx{tx: tx}
+	t.ctx = contextWithTx(ctx, t)
+	return t, nil
+}
+
+// WithinTransaction выполняет fn в транзакции, репозитории берут ее из контекста fn
+func (s *SQLiteDatabase) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return withinTransaction(ctx, s, fn)
 }
 
-// Migrate выполняет миграции
+// Migrate применяет миграции из migrations/ до последней версии
 func (s *SQLiteDatabase) Migrate() error {
-	// TODO: Добавить модели для миграции
-	return nil
+	return MigrateCommand(context.Background(), s, "up")
+}
+
+// sqlDB возвращает пул database/sql для миграций
+func (s *SQLiteDatabase) sqlDB() (*sql.DB, error) {
+	return s.db.DB()
 }
 
 // Stats возвращает статистику
@@ -685,7 +895,11 @@ func (s *SQLiteDatabase) DB() *gorm.DB {
 
 // Commit подтверждает транзакцию
 func (tx *SQLiteTx) Commit() error {
-	return tx.tx.Commit().Error
+	if err := tx.tx.Commit().Error; err != nil {
+		return err
+	}
+	runAfterCommit(tx.ctx)
+	return nil
 }
 
 // Rollback откатывает транзакцию
@@ -697,84 +911,98 @@ func (tx *SQLiteTx) Rollback() error {
 func (tx *SQLiteTx) Context() context.Context {
 	return tx.ctx
 }
-`, config.ModuleName)
-}
 
-// generateRepositories создает примеры репозиториев
-func (g *Generator) generateRepositories(config *ProjectConfig) error {
-	content := fmt.Sprintf(`package repository
-
-import (
-	"%s/internal/models"
-	"%s/pkg/database"
-)
+// DB возвращает транзакцию GORM для запросов репозиториев
+func (tx *SQLiteTx) DB() *gorm.DB {
+	return tx.tx
+}
 
-// UserRepository интерфейс для работы с пользователями
-type UserRepository interface {
-	database.Repository
-	GetByID(id int64) (*models.User, error)
-	GetByEmail(email string) (*models.User, error)
-	Create(user *models.User) error
-	Update(user *models.User) error
-	Delete(id int64) error
-	List(offset, limit int) ([]*models.User, error)
+// Savepoint создает точку сохранения для вложенного WithinTransaction
+func (tx *SQLiteTx) Savepoint(name string) error {
+	return tx.tx.SavePoint(name).Error
 }
 
-// UserRepositoryImpl реализация репозитория пользователей
-type UserRepositoryImpl struct {
-	*database.BaseRepository
+// RollbackTo откатывает транзакцию к точке сохранения
+func (tx *SQLiteTx) RollbackTo(name string) error {
+	return tx.tx.RollbackTo(name).Error
+}
+`, config.ModuleName)
 }
 
-// NewUserRepository создает новый репозиторий пользователей
-func NewUserRepository(db database.Database) UserRepository {
-	return &UserRepositoryImpl{
-		BaseRepository: database.NewBaseRepository(db),
+// gormTracingImport возвращает импорт GORM плагина OpenTelemetry, если включен --otel
+func gormTracingImport(config *ProjectConfig) string {
+	if !config.EnableOTel {
+		return ""
 	}
+	return `
+	gormtracing "gorm.io/plugin/opentelemetry/tracing"`
 }
 
-// GetByID получает пользователя по ID
-func (r *UserRepositoryImpl) GetByID(id int64) (*models.User, error) {
-	// TODO: Реализовать получение пользователя по ID
-	return nil, nil
+// gormTracingSetup подключает трассировку запросов GORM.
+// Вставляется в шаблон fmt.Sprintf, поэтому %% экранирован
+func gormTracingSetup(config *ProjectConfig) string {
+	if !config.EnableOTel {
+		return ""
+	}
+	return `
+	// Трассировка запросов, выполненных с db.WithContext(ctx)
+	if err := db.Use(gormtracing.NewPlugin(gormtracing.WithoutMetrics())); err != nil {
+		return nil, fmt.Errorf("ошибка подключения трассировки GORM: %%w", err)
+	}
+`
 }
 
-// GetByEmail получает пользователя по email
-func (r *UserRepositoryImpl) GetByEmail(email string) (*models.User, error) {
-	// TODO: Реализовать получение пользователя по email
-	return nil, nil
+// mongoTracingImport возвращает импорт otelmongo, если включен --otel
+func mongoTracingImport(config *ProjectConfig) string {
+	if !config.EnableOTel {
+		return ""
+	}
+	return `
+	"go.opentelemetry.io/contrib/instrumentation/go.mongodb.org/mongo-driver/mongo/otelmongo"`
 }
 
-// Create создает нового пользователя
-func (r *UserRepositoryImpl) Create(user *models.User) error {
-	// TODO: Реализовать создание пользователя
-	return nil
+// mongoTracingSetup подключает трассировку команд MongoDB
+func mongoTracingSetup(config *ProjectConfig) string {
+	if !config.EnableOTel {
+		return ""
+	}
+	return `
+	clientOptions.SetMonitor(otelmongo.NewMonitor())`
 }
 
-// Update обновляет пользователя
-func (r *UserRepositoryImpl) Update(user *models.User) error {
-	// TODO: Реализовать обновление пользователя
-	return nil
-}
+// modelTag возвращает тег поля модели: json, gorm для GORM и MongoDB, db для sqlx, bson для MongoDB
+func modelTag(config *ProjectConfig, column, gorm string) string {
+	tag := `json:"` + column + `"`
 
-// Delete удаляет пользователя
-func (r *UserRepositoryImpl) Delete(id int64) error {
-	// TODO: Реализовать удаление пользователя
-	return nil
-}
+	switch dataAccess(config) {
+	case "gorm", "":
+		if gorm != "" {
+			tag += ` gorm:"` + gorm + `"`
+		}
+	case "sqlx":
+		tag += ` db:"` + column + `"`
+	}
 
-// List возвращает список пользователей
-func (r *UserRepositoryImpl) List(offset, limit int) ([]*models.User, error) {
-	// TODO: Реализовать получение списка пользователей
-	return nil, nil
-}
-`, config.ModuleName, config.ModuleName)
+	if strings.ToLower(config.Database) == "mongodb" {
+		bson := column
+		if column == "id" {
+			bson = "_id,omitempty"
+		}
+		tag += ` bson:"` + bson + `"`
+	}
 
-	repoPath := filepath.Join(g.projectPath, "internal/repository/user.go")
-	return os.WriteFile(repoPath, []byte(content), 0644)
+	return "`" + tag + "`"
 }
 
 // generateModels создает примеры моделей
 func (g *Generator) generateModels(config *ProjectConfig) error {
+	modelsPath := filepath.Join(g.projectPath, "internal/models/models.go")
+
+	// В ClickHouse вместо пользователей и продуктов хранятся аналитические события
+	if strings.ToLower(config.Database) == "clickhouse" {
+		return os.WriteFile(modelsPath, []byte(g.generateEventModel()), 0644)
+	}
+
 	content := `package models
 
 import (
@@ -783,11 +1011,11 @@ import (
 
 // User модель пользователя
 type User struct {
-	ID        int64     ` + "`" + `json:"id" gorm:"primaryKey;autoIncrement"` + "`" + `
-	Email     string    ` + "`" + `json:"email" gorm:"uniqueIndex;not null"` + "`" + `
-	Name      string    ` + "`" + `json:"name" gorm:"not null"` + "`" + `
-	CreatedAt time.Time ` + "`" + `json:"created_at" gorm:"autoCreateTime"` + "`" + `
-	UpdatedAt time.Time ` + "`" + `json:"updated_at" gorm:"autoUpdateTime"` + "`" + `
+	ID        int64     ` + modelTag(config, "id", "primaryKey;autoIncrement") + `
+	Email     string    ` + modelTag(config, "email", "uniqueIndex;not null") + `
+	Name      string    ` + modelTag(config, "name", "not null") + `
+	CreatedAt time.Time ` + modelTag(config, "created_at", "autoCreateTime") + `
+	UpdatedAt time.Time ` + modelTag(config, "updated_at", "autoUpdateTime") + `
 }
 
 // TableName возвращает имя таблицы
@@ -797,12 +1025,12 @@ func (User) TableName() string {
 
 // Product модель продукта (пример)
 type Product struct {
-	ID          int64     ` + "`" + `json:"id" gorm:"primaryKey;autoIncrement"` + "`" + `
-	Name        string    ` + "`" + `json:"name" gorm:"not null"` + "`" + `
-	Description string    ` + "`" + `json:"description"` + "`" + `
-	Price       float64   ` + "`" + `json:"price" gorm:"not null"` + "`" + `
-	CreatedAt   time.Time ` + "`" + `json:"created_at" gorm:"autoCreateTime"` + "`" + `
-	UpdatedAt   time.Time ` + "`" + `json:"updated_at" gorm:"autoUpdateTime"` + "`" + `
+	ID          int64     ` + modelTag(config, "id", "primaryKey;autoIncrement") + `
+	Name        string    ` + modelTag(config, "name", "not null") + `
+	Description string    ` + modelTag(config, "description", "") + `
+	Price       float64   ` + modelTag(config, "price", "not null") + `
+	CreatedAt   time.Time ` + modelTag(config, "created_at", "autoCreateTime") + `
+	UpdatedAt   time.Time ` + modelTag(config, "updated_at", "autoUpdateTime") + `
 }
 
 // TableName возвращает имя таблицы
@@ -811,6 +1039,5 @@ func (Product) TableName() string {
 }
 `
 
-	modelsPath := filepath.Join(g.projectPath, "internal/models/models.go")
 	return os.WriteFile(modelsPath, []byte(content), 0644)
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 )
 
 // generateDockerFiles создает Docker файлы
@@ -23,11 +24,28 @@ func (g *Generator) generateDockerFiles(config *ProjectConfig) error {
 		return err
 	}
 
+	// Создаем скрипт инициализации replica set для транзакций MongoDB
+	if strings.ToLower(config.Database) == "mongodb" {
+		scriptPath := filepath.Join(g.projectPath, "scripts/mongo-init-replica-set.js")
+		if err := os.WriteFile(scriptPath, []byte(g.generateMongoReplicaSetInit()), 0644); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
 // generateDockerfile создает Dockerfile
 func (g *Generator) generateDockerfile(config *ProjectConfig) error {
+	// Каталог файла SQLite создается заранее, чтобы том sqlite_data принадлежал пользователю сервиса
+	dataDir := ""
+	if strings.ToLower(config.Database) == "sqlite" {
+		dataDir = `
+# Создаем каталог файла SQLite, в docker-compose на него монтируется том
+RUN mkdir -p /app/data
+`
+	}
+
 	content := fmt.Sprintf(`# Build stage
 FROM golang:1.21-alpine AS builder
 
@@ -46,8 +64,10 @@ RUN go mod download
 # Копируем исходный код
 COPY . .
 
-# Собираем приложение
-RUN CGO_ENABLED=0 GOOS=linux go build -ldflags="-w -s" -o main cmd/main.go
+# Собираем приложение, версия передается через --build-arg
+ARG VERSION=dev
+ARG COMMIT=none
+RUN CGO_ENABLED=0 GOOS=linux go build -ldflags="-w -s -X main.version=${VERSION} -X main.commit=${COMMIT}" -o main cmd/main.go
 
 # Production stage
 FROM alpine:latest
@@ -64,8 +84,8 @@ WORKDIR /app
 
 # Копируем бинарный файл из build stage
 COPY --from=builder /app/main .
-COPY --from=builder /app/config.yaml .
-
+COPY --from=builder /app/config*.yaml ./
+`+dataDir+`
 # Устанавливаем владельца файлов
 RUN chown -R %s:%s /app
 
@@ -85,6 +105,9 @@ CMD ["./main"]
 
 // generateDockerCompose создает docker-compose.yml
 func (g *Generator) generateDockerCompose(config *ProjectConfig) error {
+	// БД приходит как из опроса (PostgreSQL), так и из флага --database (postgresql)
+	database := strings.ToLower(config.Database)
+
 	content := fmt.Sprintf(`version: '3.8'
 
 services:
@@ -102,69 +125,233 @@ services:
 	}
 
 	content += `
+    # Секреты (APP_DATABASE_PASSWORD, APP_ADMIN_TOKEN) берутся из .env, созданного при init
+    env_file:
+      - .env
     environment:
-      - APP_ENV=production
+      - APP_ENV=production`
+
+	// Адреса зависимостей внутри сети docker-compose
+	switch database {
+	case "postgresql":
+		content += `
+      - APP_DATABASE_HOST=postgres`
+	case "mysql":
+		content += `
+      - APP_DATABASE_HOST=mysql`
+	case "mongodb":
+		content += `
+      - APP_DATABASE_URI=mongodb://mongodb:27017/?replicaSet=rs0`
+	case "cockroachdb":
+		content += `
+      - APP_DATABASE_HOST=cockroachdb`
+	case "clickhouse":
+		content += `
+      - APP_DATABASE_HOST=clickhouse`
+	}
+	if config.EnableOTel {
+		content += `
+      - APP_TRACING_ENDPOINT=jaeger:4317`
+	}
+	if hasCache(config) {
+		content += `
+      - APP_CACHE_REDIS_ADDR=redis:6379`
+	}
+
+	content += `
     volumes:
       - ./config.yaml:/app/config.yaml:ro
+      - ./config.production.yaml:/app/config.production.yaml:ro`
+
+	// Файл SQLite хранится в томе и переживает пересоздание контейнера
+	if database == "sqlite" {
+		content += `
+      - sqlite_data:/app/data`
+	}
+
+	// Зависимости сервиса: хранилища должны пройти healthcheck, так как при запуске приложение
+	// подключается к ним и применяет миграции, Jaeger достаточно запустить
+	var dependsOn []string
+	if config.EnableOTel {
+		dependsOn = append(dependsOn, "jaeger:\n        condition: service_started")
+	}
+	if hasCache(config) {
+		dependsOn = append(dependsOn, "redis:\n        condition: service_healthy")
+	}
+	switch database {
+	case "postgresql":
+		dependsOn = append(dependsOn, "postgres:\n        condition: service_healthy")
+	case "mysql":
+		dependsOn = append(dependsOn, "mysql:\n        condition: service_healthy")
+	case "mongodb":
+		// Приложение стартует после инициализации replica set, иначе транзакции недоступны
+		dependsOn = append(dependsOn, "mongodb:\n        condition: service_healthy")
+	case "cockroachdb":
+		dependsOn = append(dependsOn, "cockroachdb:\n        condition: service_healthy")
+	case "clickhouse":
+		dependsOn = append(dependsOn, "clickhouse:\n        condition: service_healthy")
+	}
+	if len(dependsOn) > 0 {
+		content += `
     depends_on:`
+		for _, dependency := range dependsOn {
+			content += `
+      ` + dependency
+		}
+	}
+
+	content += `
+    networks:
+      - app-network`
 
 	// Добавляем БД если нужно
-	switch config.Database {
-	case "PostgreSQL":
+	switch database {
+	case "postgresql":
 		content += `
-      - postgres
-    networks:
-      - app-network
 
   postgres:
     image: postgres:15-alpine
     environment:
       POSTGRES_DB: ` + config.Name + `
       POSTGRES_USER: postgres
-      POSTGRES_PASSWORD: password
+      POSTGRES_PASSWORD: ${APP_DATABASE_PASSWORD:?задайте APP_DATABASE_PASSWORD в .env}
     ports:
       - "5432:5432"
     volumes:
       - postgres_data:/var/lib/postgresql/data
+    # По TCP: при первом запуске временный сервер инициализации слушает только unix сокет
+    healthcheck:
+      test: ["CMD-SHELL", "pg_isready -h 127.0.0.1 -U postgres -d ` + config.Name + `"]
+      interval: 5s
+      timeout: 5s
+      start_period: 10s
+      retries: 10
     networks:
       - app-network`
 
-	case "MySQL":
+	case "mysql":
 		content += `
-      - mysql
-    networks:
-      - app-network
 
   mysql:
     image: mysql:8.0
     environment:
       MYSQL_DATABASE: ` + config.Name + `
-      MYSQL_ROOT_PASSWORD: password
+      MYSQL_ROOT_PASSWORD: ${APP_DATABASE_PASSWORD:?задайте APP_DATABASE_PASSWORD в .env}
     ports:
       - "3306:3306"
     volumes:
       - mysql_data:/var/lib/mysql
+    # По TCP: при первом запуске временный сервер инициализации работает с --skip-networking
+    healthcheck:
+      test: ["CMD", "mysqladmin", "ping", "-h", "127.0.0.1", "--silent"]
+      interval: 5s
+      timeout: 5s
+      start_period: 30s
+      retries: 20
     networks:
       - app-network`
 
-	case "MongoDB":
+	case "mongodb":
 		content += `
-      - mongodb
-    networks:
-      - app-network
 
+  # Транзакции MongoDB работают только в replica set: запускается однонодовый rs0,
+  # healthcheck инициализирует его скриптом и ждет выбора primary
   mongodb:
     image: mongo:7
+    command: ["--replSet", "rs0", "--bind_ip_all"]
     environment:
       MONGO_INITDB_DATABASE: ` + config.Name + `
     ports:
       - "27017:27017"
     volumes:
       - mongodb_data:/data/db
+      - ./scripts/mongo-init-replica-set.js:/scripts/mongo-init-replica-set.js:ro
+    healthcheck:
+      test: ["CMD", "mongosh", "--quiet", "/scripts/mongo-init-replica-set.js"]
+      interval: 5s
+      timeout: 10s
+      start_period: 10s
+      retries: 10
     networks:
       - app-network`
-	default:
+	case "cockroachdb":
+		// Однонодовый кластер без TLS для локальной разработки, база создается из COCKROACH_DATABASE
 		content += `
+
+  cockroachdb:
+    image: cockroachdb/cockroach:v23.2.0
+    command: start-single-node --insecure
+    environment:
+      COCKROACH_DATABASE: ` + config.Name + `
+    ports:
+      - "26257:26257"
+      - "8081:8080"
+    volumes:
+      - cockroach_data:/cockroach/cockroach-data
+    healthcheck:
+      test: ["CMD", "/cockroach/cockroach", "node", "status", "--insecure"]
+      interval: 5s
+      timeout: 5s
+      start_period: 10s
+      retries: 10
+    networks:
+      - app-network`
+
+	case "clickhouse":
+		content += `
+
+  clickhouse:
+    image: clickhouse/clickhouse-server:23.8-alpine
+    environment:
+      CLICKHOUSE_DB: ` + config.Name + `
+      CLICKHOUSE_USER: default
+      CLICKHOUSE_PASSWORD: ${APP_DATABASE_PASSWORD:?задайте APP_DATABASE_PASSWORD в .env}
+    ports:
+      - "9000:9000"
+      - "8123:8123"
+    ulimits:
+      nofile:
+        soft: 262144
+        hard: 262144
+    volumes:
+      - clickhouse_data:/var/lib/clickhouse
+    healthcheck:
+      test: ["CMD-SHELL", "clickhouse-client --password \"$$CLICKHOUSE_PASSWORD\" --query 'SELECT 1'"]
+      interval: 5s
+      timeout: 5s
+      start_period: 10s
+      retries: 10
+    networks:
+      - app-network`
+	}
+
+	if config.EnableOTel {
+		content += `
+
+  jaeger:
+    image: jaegertracing/all-in-one:1.52
+    environment:
+      COLLECTOR_OTLP_ENABLED: "true"
+    ports:
+      - "16686:16686"
+      - "4317:4317"
+    networks:
+      - app-network`
+	}
+
+	if hasCache(config) {
+		content += `
+
+  redis:
+    image: redis:7-alpine
+    command: ["redis-server", "--maxmemory", "256mb", "--maxmemory-policy", "allkeys-lru"]
+    ports:
+      - "6379:6379"
+    healthcheck:
+      test: ["CMD", "redis-cli", "ping"]
+      interval: 5s
+      timeout: 3s
+      retries: 5
     networks:
       - app-network`
 	}
@@ -177,26 +364,49 @@ networks:
 `
 
 	// Добавляем volumes если есть БД
-	if config.Database != "In-Memory" && config.Database != "Без БД" {
+	volume := ""
+	switch database {
+	case "postgresql":
+		volume = "postgres_data"
+	case "mysql":
+		volume = "mysql_data"
+	case "mongodb":
+		volume = "mongodb_data"
+	case "cockroachdb":
+		volume = "cockroach_data"
+	case "clickhouse":
+		volume = "clickhouse_data"
+	case "sqlite":
+		volume = "sqlite_data"
+	}
+	if volume != "" {
 		content += `
-volumes:`
-		switch config.Database {
-		case "PostgreSQL":
-			content += `
-  postgres_data:`
-		case "MySQL":
-			content += `
-  mysql_data:`
-		case "MongoDB":
-			content += `
-  mongodb_data:`
-		}
+volumes:
+  ` + volume + `:`
 	}
 
 	dockerComposePath := filepath.Join(g.projectPath, "docker-compose.yml")
 	return os.WriteFile(dockerComposePath, []byte(content), 0644)
 }
 
+// generateMongoReplicaSetInit генерирует скрипт mongosh, который инициализирует replica set rs0
+// и завершается успешно, только когда узел стал primary
+func (g *Generator) generateMongoReplicaSetInit() string {
+	return `// Инициализирует однонодовый replica set rs0, в standalone MongoDB транзакции не поддерживаются.
+// Выполняется healthcheck контейнера mongodb, повторный запуск только проверяет состояние узла
+try {
+  rs.status();
+} catch (e) {
+  if (e.codeName !== "NotYetInitialized") {
+    throw e;
+  }
+  rs.initiate({ _id: "rs0", members: [{ _id: 0, host: "mongodb:27017" }] });
+}
+
+quit(db.hello().isWritablePrimary ? 0 : 1);
+`
+}
+
 // generateDockerIgnore создает .dockerignore
 func (g *Generator) generateDockerIgnore() error {
 	content := `# Git
@@ -228,6 +438,9 @@ docker-compose.yml
 .DS_Store
 Thumbs.db
 
+# TLS сертификаты
+certs/
+
 # Logs
 *.log
 logs/
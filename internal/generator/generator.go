@@ -12,7 +12,12 @@ type ProjectConfig struct {
 	ModuleName string
 	Framework  string
 	Database   string
+	Logger     string
+	DataAccess string
+	Cache      string
 	EnableGRPC bool
+	EnableAuth bool
+	EnableOTel bool
 	Path       string
 }
 
@@ -50,16 +55,67 @@ func (g *Generator) Generate(config *ProjectConfig) error {
 		return fmt.Errorf("ошибка создания основных файлов: %w", err)
 	}
 
+	// Создаем TLS утилиты
+	if err := g.generateTLS(config); err != nil {
+		return fmt.Errorf("ошибка создания TLS утилит: %w", err)
+	}
+
 	// Создаем handlers
 	if err := g.generateHandlers(config); err != nil {
 		return fmt.Errorf("ошибка создания handlers: %w", err)
 	}
 
+	// Создаем request ID
+	if err := g.generateRequestID(config); err != nil {
+		return fmt.Errorf("ошибка создания request ID: %w", err)
+	}
+
+	// Создаем трассировку если нужно
+	if config.EnableOTel {
+		if err := g.generateTracing(config); err != nil {
+			return fmt.Errorf("ошибка создания трассировки: %w", err)
+		}
+	}
+
+	// Создаем метрики
+	if err := g.generateMetrics(config); err != nil {
+		return fmt.Errorf("ошибка создания метрик: %w", err)
+	}
+
+	// Создаем проверки состояния
+	if err := g.generateHealth(config); err != nil {
+		return fmt.Errorf("ошибка создания проверок состояния: %w", err)
+	}
+
+	// Создаем admin сервер
+	if err := g.generateAdmin(config); err != nil {
+		return fmt.Errorf("ошибка создания admin сервера: %w", err)
+	}
+
+	// Создаем rate limiting
+	if err := g.generateRateLimit(config); err != nil {
+		return fmt.Errorf("ошибка создания rate limiting: %w", err)
+	}
+
+	// Создаем модуль аутентификации если нужно
+	if config.EnableAuth {
+		if err := g.generateAuth(config); err != nil {
+			return fmt.Errorf("ошибка создания модуля аутентификации: %w", err)
+		}
+	}
+
 	// Создаем слой БД
 	if err := g.generateDatabaseLayer(config); err != nil {
 		return fmt.Errorf("ошибка создания слоя БД: %w", err)
 	}
 
+	// Создаем кэш если нужно
+	if hasCache(config) {
+		if err := g.generateCache(config); err != nil {
+			return fmt.Errorf("ошибка создания кэша: %w", err)
+		}
+	}
+
 	// Создаем gRPC если нужно
 	if config.EnableGRPC {
 		if err := g.generateGRPC(config); err != nil {
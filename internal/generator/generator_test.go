@@ -3,6 +3,7 @@ package generator
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -98,22 +99,1393 @@ func TestProjectConfigValidation(t *testing.T) {
 }
 
 func TestSupportedDatabases(t *testing.T) {
-	supportedDatabases := []string{
-		"postgresql",
-		"mysql",
-		"mongodb",
-		"in-memory",
-		"без бд",
+	// Значения флага --database: compose сервисы БД, переменная подключения приложения в compose
+	// и параметры подключения из секции database в config.yaml
+	tests := []struct {
+		database string
+		services []string
+		env      string
+		settings map[string]string
+	}{
+		{
+			database: "postgresql",
+			services: []string{"postgres"},
+			env:      "APP_DATABASE_HOST=postgres",
+			settings: map[string]string{"type": "postgres", "host": "localhost", "port": "5432"},
+		},
+		{
+			database: "mysql",
+			services: []string{"mysql"},
+			env:      "APP_DATABASE_HOST=mysql",
+			settings: map[string]string{"type": "mysql", "host": "localhost", "port": "3306"},
+		},
+		{
+			database: "mongodb",
+			services: []string{"mongodb"},
+			env:      "APP_DATABASE_URI=mongodb://mongodb:27017/?replicaSet=rs0",
+			settings: map[string]string{"type": "mongodb", "uri": "mongodb://localhost:27017/?directConnection=true"},
+		},
+		{
+			database: "cockroachdb",
+			services: []string{"cockroachdb"},
+			env:      "APP_DATABASE_HOST=cockroachdb",
+			settings: map[string]string{"type": "cockroachdb", "host": "localhost", "port": "26257"},
+		},
+		{
+			database: "clickhouse",
+			services: []string{"clickhouse"},
+			env:      "APP_DATABASE_HOST=clickhouse",
+			settings: map[string]string{"type": "clickhouse", "host": "localhost", "port": "9000"},
+		},
+		{
+			database: "sqlite",
+			settings: map[string]string{"type": "sqlite", "path": "data/test-service.db", "journal_mode": "wal"},
+		},
+		{
+			database: "in-memory",
+			settings: map[string]string{"type": "sqlite", "path": ":memory:"},
+		},
+		{
+			database: "без бд",
+		},
 	}
 
-	for _, db := range supportedDatabases {
+	for _, tt := range tests {
+		tempDir, err := os.MkdirTemp("", "generator-test-*")
+		if err != nil {
+			t.Fatalf("Failed to create temp dir: %v", err)
+		}
+		defer os.RemoveAll(tempDir)
+
+		projectPath := filepath.Join(tempDir, "test-project")
+		generator := New(projectPath)
+
 		config := &ProjectConfig{
-			Database: db,
+			Name:       "test-service",
+			ModuleName: "github.com/test/test-service",
+			Framework:  "gin",
+			Database:   tt.database,
+			Path:       projectPath,
+		}
+
+		if err := generator.Generate(config); err != nil {
+			t.Fatalf("Failed to generate project with %s: %v", tt.database, err)
+		}
+
+		compose, err := os.ReadFile(filepath.Join(projectPath, "docker-compose.yml"))
+		if err != nil {
+			t.Fatalf("Failed to read docker-compose.yml: %v", err)
+		}
+
+		services := yamlKeys(yamlChildren(string(compose), "services"))
+		expectedServices := append([]string{config.Name}, tt.services...)
+		if strings.Join(services, ",") != strings.Join(expectedServices, ",") {
+			t.Errorf("%s: expected compose services %v, got %v", tt.database, expectedServices, services)
+		}
+
+		dependsOn := yamlKeys(yamlChildren(string(compose), "services", config.Name, "depends_on"))
+		if strings.Join(dependsOn, ",") != strings.Join(tt.services, ",") {
+			t.Errorf("%s: expected app to depend on %v, got %v", tt.database, tt.services, dependsOn)
+		}
+
+		// Приложение при запуске подключается к БД и применяет миграции, поэтому ждет ее healthcheck
+		for _, service := range tt.services {
+			if len(yamlChildren(string(compose), "services", service, "healthcheck")) == 0 {
+				t.Errorf("%s: expected healthcheck for compose service %s", tt.database, service)
+			}
+			condition := yamlValue(yamlChildren(string(compose), "services", config.Name, "depends_on", service), "condition")
+			if condition != "service_healthy" {
+				t.Errorf("%s: expected app to wait for healthy %s, got %q", tt.database, service, condition)
+			}
+		}
+
+		if tt.env != "" {
+			env := yamlChildren(string(compose), "services", config.Name, "environment")
+			if !containsString(env, "- "+tt.env) {
+				t.Errorf("%s: expected %s in app environment, got %v", tt.database, tt.env, env)
+			}
+		}
+
+		configYAML, err := os.ReadFile(filepath.Join(projectPath, "config.yaml"))
+		if err != nil {
+			t.Fatalf("Failed to read config.yaml: %v", err)
+		}
+
+		settings := yamlChildren(string(configYAML), "database")
+		if len(tt.settings) == 0 && len(settings) != 0 {
+			t.Errorf("%s: database section must not be generated, got %v", tt.database, settings)
+		}
+		for key, expected := range tt.settings {
+			if value := yamlValue(settings, key); value != expected {
+				t.Errorf("%s: expected database.%s %q, got %q", tt.database, key, expected, value)
+			}
+		}
+	}
+}
+
+// yamlChildren возвращает строки, непосредственно вложенные в ключ path, без комментариев.
+// Достаточно для YAML, который создает генератор: отступы пробелами, без многострочных значений
+func yamlChildren(content string, path ...string) []string {
+	lines := strings.Split(content, "\n")
+	start, indent := 0, -1
+
+	for _, key := range path {
+		found := false
+		childIndent := -1
+		for i := start; i < len(lines) && !found; i++ {
+			trimmed := strings.TrimSpace(lines[i])
+			if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+				continue
+			}
+			lineIndent := len(lines[i]) - len(strings.TrimLeft(lines[i], " "))
+			if lineIndent <= indent {
+				break
+			}
+			if childIndent == -1 {
+				childIndent = lineIndent
+			}
+			if lineIndent == childIndent && strings.HasPrefix(trimmed, key+":") {
+				start, indent, found = i+1, lineIndent, true
+			}
+		}
+		if !found {
+			return nil
+		}
+	}
+
+	var children []string
+	childIndent := -1
+	for _, line := range lines[start:] {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		lineIndent := len(line) - len(strings.TrimLeft(line, " "))
+		if lineIndent <= indent {
+			break
+		}
+		if childIndent == -1 {
+			childIndent = lineIndent
+		}
+		if lineIndent == childIndent {
+			children = append(children, trimmed)
+		}
+	}
+
+	return children
+}
+
+// yamlKeys возвращает ключи блоков из строк yamlChildren
+func yamlKeys(children []string) []string {
+	var keys []string
+	for _, child := range children {
+		if strings.HasSuffix(child, ":") {
+			keys = append(keys, strings.TrimSuffix(child, ":"))
+		}
+	}
+	return keys
+}
+
+// yamlValue возвращает скалярное значение key из строк yamlChildren без кавычек и комментария
+func yamlValue(children []string, key string) string {
+	for _, child := range children {
+		if value, ok := strings.CutPrefix(child, key+":"); ok {
+			value, _, _ = strings.Cut(value, "#")
+			return strings.Trim(strings.TrimSpace(value), `"`)
+		}
+	}
+	return ""
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func TestGenerateTLS(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "generator-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	projectPath := filepath.Join(tempDir, "test-project")
+	generator := New(projectPath)
+
+	config := &ProjectConfig{
+		Name:       "test-service",
+		ModuleName: "github.com/test/test-service",
+		Framework:  "gin",
+		Database:   "postgresql",
+		EnableGRPC: true,
+		Path:       projectPath,
+	}
+
+	if err := generator.Generate(config); err != nil {
+		t.Fatalf("Failed to generate project: %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(projectPath, "pkg/tlsutil/tlsutil.go")); os.IsNotExist(err) {
+		t.Error("Expected pkg/tlsutil/tlsutil.go to be generated")
+	}
+
+	configGo, err := os.ReadFile(filepath.Join(projectPath, "internal/config/config.go"))
+	if err != nil {
+		t.Fatalf("Failed to read config.go: %v", err)
+	}
+	if !strings.Contains(string(configGo), "type TLSConfig struct") {
+		t.Error("Expected TLSConfig in generated config.go")
+	}
+
+	makefile, err := os.ReadFile(filepath.Join(projectPath, "Makefile"))
+	if err != nil {
+		t.Fatalf("Failed to read Makefile: %v", err)
+	}
+	if !strings.Contains(string(makefile), "certs:") {
+		t.Error("Expected certs target in generated Makefile")
+	}
+}
+
+func TestGenerateAuth(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "generator-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	projectPath := filepath.Join(tempDir, "test-project")
+	generator := New(projectPath)
+
+	config := &ProjectConfig{
+		Name:       "test-service",
+		ModuleName: "github.com/test/test-service",
+		Framework:  "echo",
+		Database:   "Без БД",
+		EnableGRPC: true,
+		EnableAuth: true,
+		Path:       projectPath,
+	}
+
+	if err := generator.Generate(config); err != nil {
+		t.Fatalf("Failed to generate project: %v", err)
+	}
+
+	expectedFiles := []string{
+		"pkg/auth/auth.go",
+		"pkg/auth/jwks.go",
+		"internal/middleware/auth.go",
+		"internal/handlers/auth.go",
+		"internal/handlers/auth_test.go",
+		"internal/grpc/interceptors.go",
+	}
+	for _, file := range expectedFiles {
+		if _, err := os.Stat(filepath.Join(projectPath, file)); os.IsNotExist(err) {
+			t.Errorf("Expected %s to be generated", file)
+		}
+	}
+
+	interceptors, err := os.ReadFile(filepath.Join(projectPath, "internal/grpc/interceptors.go"))
+	if err != nil {
+		t.Fatalf("Failed to read interceptors.go: %v", err)
+	}
+	if !strings.Contains(string(interceptors), "AuthUnaryInterceptor") {
+		t.Error("Expected AuthUnaryInterceptor in generated interceptors.go")
+	}
+
+	goMod, err := os.ReadFile(filepath.Join(projectPath, "go.mod"))
+	if err != nil {
+		t.Fatalf("Failed to read go.mod: %v", err)
+	}
+	if !strings.Contains(string(goMod), "github.com/golang-jwt/jwt/v5") {
+		t.Error("Expected jwt dependency in generated go.mod")
+	}
+}
+
+func TestGenerateCache(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "generator-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	projectPath := filepath.Join(tempDir, "test-project")
+	generator := New(projectPath)
+
+	config := &ProjectConfig{
+		Name:       "test-service",
+		ModuleName: "github.com/test/test-service",
+		Framework:  "fiber",
+		Database:   "In-Memory",
+		Cache:      "redis",
+		Path:       projectPath,
+	}
+
+	if err := generator.Generate(config); err != nil {
+		t.Fatalf("Failed to generate project: %v", err)
+	}
+
+	expectedContent := map[string]string{
+		"pkg/cache/cache.go":                     "func GetOrLoad[T any](",
+		"pkg/cache/memory.go":                    "func NewMemoryCache() *MemoryCache",
+		"pkg/cache/redis.go":                     "func NewRedisCache(cfg config.CacheRedisConfig) (*RedisCache, error)",
+		"internal/repository/user_cache.go":      "func NewCachedUserRepository(repo UserRepository, c cache.Cache, ttl time.Duration) UserRepository",
+		"internal/repository/user_cache_test.go": "func TestCachedUserRepository(t *testing.T)",
+		"internal/config/config.go":              "type CacheConfig struct",
+		"internal/config/validate.go":            `v.required("cache.redis.addr", cache.Redis.Addr)`,
+		"internal/app/app.go":                    `health.NewChecker("cache", health.Redis(redisCache.Client()).Check)`,
+		"config.yaml":                            `backend: "redis"`,
+		"docker-compose.yml":                     "image: redis:7-alpine",
+	}
+	for file, expected := range expectedContent {
+		content, err := os.ReadFile(filepath.Join(projectPath, file))
+		if err != nil {
+			t.Errorf("Failed to read %s: %v", file, err)
+			continue
+		}
+		if !strings.Contains(string(content), expected) {
+			t.Errorf("Expected %q in %s", expected, file)
+		}
+	}
+
+	// Приложение ждет готовности Redis по его healthcheck
+	compose, err := os.ReadFile(filepath.Join(projectPath, "docker-compose.yml"))
+	if err != nil {
+		t.Fatalf("Failed to read docker-compose.yml: %v", err)
+	}
+	if !strings.Contains(string(compose), "redis:\n        condition: service_healthy") {
+		t.Error("Expected app to depend on healthy redis in docker-compose.yml")
+	}
+}
+
+func TestGenerateOTel(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "generator-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	projectPath := filepath.Join(tempDir, "test-project")
+	generator := New(projectPath)
+
+	config := &ProjectConfig{
+		Name:       "test-service",
+		ModuleName: "github.com/test/test-service",
+		Framework:  "fiber",
+		Database:   "mongodb",
+		EnableGRPC: true,
+		EnableOTel: true,
+		Path:       projectPath,
+	}
+
+	if err := generator.Generate(config); err != nil {
+		t.Fatalf("Failed to generate project: %v", err)
+	}
+
+	expectedContent := map[string]string{
+		"pkg/tracing/tracing.go":         "func Init(",
+		"internal/middleware/tracing.go": "func TracingMiddleware(",
+		"internal/grpc/server.go":        "otelgrpc.NewServerHandler()",
+		"pkg/database/database.go":       "otelmongo.NewMonitor()",
+		"docker-compose.yml":             "jaeger:",
+	}
+	for file, expected := range expectedContent {
+		content, err := os.ReadFile(filepath.Join(projectPath, file))
+		if err != nil {
+			t.Errorf("Failed to read %s: %v", file, err)
+			continue
+		}
+		if !strings.Contains(string(content), expected) {
+			t.Errorf("Expected %q in %s", expected, file)
+		}
+	}
+}
+
+func TestGenerateMetrics(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "generator-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	projectPath := filepath.Join(tempDir, "test-project")
+	generator := New(projectPath)
+
+	config := &ProjectConfig{
+		Name:       "test-service",
+		ModuleName: "github.com/test/test-service",
+		Framework:  "echo",
+		Database:   "postgresql",
+		EnableGRPC: true,
+		Path:       projectPath,
+	}
+
+	if err := generator.Generate(config); err != nil {
+		t.Fatalf("Failed to generate project: %v", err)
+	}
+
+	expectedContent := map[string]string{
+		"pkg/metrics/metrics.go":         "func (r *Registry) RegisterDatabase(",
+		"internal/middleware/metrics.go": "func MetricsMiddleware(",
+		"internal/handlers/handler.go":   "echo.WrapHandler(h.registry.Handler())",
+		"internal/app/app.go":            "registry.RegisterDatabase(db)",
+		"internal/grpc/interceptors.go":  "MetricsUnaryInterceptor(s.registry)",
+		"internal/config/config.go":      "Metrics    MetricsConfig",
+		"config.yaml":                    "metrics:",
+		"go.mod":                         "github.com/prometheus/client_golang",
+		"pkg/metrics/metrics_test.go":    "func TestHandlerExposesHTTPMetrics(",
+	}
+	for file, expected := range expectedContent {
+		content, err := os.ReadFile(filepath.Join(projectPath, file))
+		if err != nil {
+			t.Errorf("Failed to read %s: %v", file, err)
+			continue
+		}
+		if !strings.Contains(string(content), expected) {
+			t.Errorf("Expected %q in %s", expected, file)
+		}
+	}
+}
+
+func TestGenerateHealth(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "generator-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	projectPath := filepath.Join(tempDir, "test-project")
+	generator := New(projectPath)
+
+	config := &ProjectConfig{
+		Name:       "test-service",
+		ModuleName: "github.com/test/test-service",
+		Framework:  "gin",
+		Database:   "mysql",
+		Path:       projectPath,
+	}
+
+	if err := generator.Generate(config); err != nil {
+		t.Fatalf("Failed to generate project: %v", err)
+	}
+
+	expectedContent := map[string]string{
+		"pkg/health/health.go":         "func (r *Registry) Handler(probe Probe) http.Handler",
+		"pkg/health/checkers.go":       "func Database(db database.Database) Checker",
+		"pkg/health/disk_unix.go":      "//go:build linux || darwin || freebsd",
+		"pkg/health/health_test.go":    "func TestReadinessFailsUntilStarted(",
+		"internal/handlers/handler.go": `router.GET("/readyz", gin.WrapH(h.checks.Handler(health.Readiness)))`,
+		"internal/app/app.go":          "checks.Register(health.Database(db))",
+		"config.yaml":                  "min_free_mb:",
+	}
+	for file, expected := range expectedContent {
+		content, err := os.ReadFile(filepath.Join(projectPath, file))
+		if err != nil {
+			t.Errorf("Failed to read %s: %v", file, err)
+			continue
+		}
+		if !strings.Contains(string(content), expected) {
+			t.Errorf("Expected %q in %s", expected, file)
+		}
+	}
+}
+
+func TestGenerateConfigLayers(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "generator-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	projectPath := filepath.Join(tempDir, "test-project")
+	generator := New(projectPath)
+
+	config := &ProjectConfig{
+		Name:       "test-service",
+		ModuleName: "github.com/test/test-service",
+		Framework:  "gin",
+		Database:   "PostgreSQL",
+		Path:       projectPath,
+	}
+
+	if err := generator.Generate(config); err != nil {
+		t.Fatalf("Failed to generate project: %v", err)
+	}
+
+	expectedContent := map[string]string{
+		"internal/config/config.go":   "godotenv.Load()",
+		"internal/config/env.go":      "func applyEnv(target interface{}, prefix string) error",
+		"internal/config/env_test.go": "func TestLoadLayers(",
+		"config.production.yaml":      `level: "info"`,
+		".env.example":                "APP_DATABASE_HOST",
+		"cmd/main.go":                 `flag.String("config", "config.yaml"`,
+		"docker-compose.yml":          "APP_DATABASE_HOST=postgres",
+	}
+	for file, expected := range expectedContent {
+		content, err := os.ReadFile(filepath.Join(projectPath, file))
+		if err != nil {
+			t.Errorf("Failed to read %s: %v", file, err)
+			continue
+		}
+		if !strings.Contains(string(content), expected) {
+			t.Errorf("Expected %q in %s", expected, file)
+		}
+	}
+}
+
+func TestGenerateConfigValidation(t *testing.T) {
+	databases := map[string]string{
+		"PostgreSQL": `v.oneOf(field+".type", db.Type, "postgres")`,
+		"MongoDB":    `v.url(field+".uri", db.URI, "mongodb", "mongodb+srv")`,
+		"In-Memory":  `v.oneOf("database.type", db.Type, "sqlite")`,
+		"Без БД":     "func (c *Config) validatePorts(v *validator)",
+	}
+
+	for database, expected := range databases {
+		tempDir, err := os.MkdirTemp("", "generator-test-*")
+		if err != nil {
+			t.Fatalf("Failed to create temp dir: %v", err)
+		}
+		defer os.RemoveAll(tempDir)
+
+		projectPath := filepath.Join(tempDir, "test-project")
+		generator := New(projectPath)
+
+		config := &ProjectConfig{
+			Name:       "test-service",
+			ModuleName: "github.com/test/test-service",
+			Framework:  "gin",
+			Database:   database,
+			EnableGRPC: true,
+			Path:       projectPath,
+		}
+
+		if err := generator.Generate(config); err != nil {
+			t.Fatalf("Failed to generate project with %s: %v", database, err)
+		}
+
+		content, err := os.ReadFile(filepath.Join(projectPath, "internal/config/validate.go"))
+		if err != nil {
+			t.Fatalf("Failed to read validate.go: %v", err)
+		}
+		for _, want := range []string{expected, `listen("grpc.port", c.GRPC.Port)`} {
+			if !strings.Contains(string(content), want) {
+				t.Errorf("Expected %q in validate.go for %s", want, database)
+			}
+		}
+		if database == "Без БД" && strings.Contains(string(content), "validateDatabase") {
+			t.Errorf("validate.go should not check database section without database")
+		}
+	}
+}
+
+func TestGenerateConfigReload(t *testing.T) {
+	frameworks := map[string]string{
+		"gin":   "func CORSMiddleware(cors *config.Notifier[config.CORSConfig]) gin.HandlerFunc",
+		"fiber": "func CORSMiddleware(cors *config.Notifier[config.CORSConfig]) fiber.Handler",
+		"echo":  "func CORSMiddleware(cors *config.Notifier[config.CORSConfig]) echo.MiddlewareFunc",
+	}
+
+	for framework, middleware := range frameworks {
+		tempDir, err := os.MkdirTemp("", "generator-test-*")
+		if err != nil {
+			t.Fatalf("Failed to create temp dir: %v", err)
+		}
+		defer os.RemoveAll(tempDir)
+
+		projectPath := filepath.Join(tempDir, "test-project")
+		generator := New(projectPath)
+
+		config := &ProjectConfig{
+			Name:       "test-service",
+			ModuleName: "github.com/test/test-service",
+			Framework:  framework,
+			Database:   "PostgreSQL",
+			Path:       projectPath,
+		}
+
+		if err := generator.Generate(config); err != nil {
+			t.Fatalf("Failed to generate project with %s: %v", framework, err)
+		}
+
+		expectedContent := map[string]string{
+			"config.yaml":                       "hot_reload:",
+			"internal/config/reload.go":         "func (c *Config) Watch(ctx context.Context, interval time.Duration, onReload func(error))",
+			"internal/config/reload_test.go":    "func TestReloadRejectsStructuralChanges(",
+			"internal/middleware/middleware.go": middleware,
+			"internal/middleware/ratelimit.go":  "rateLimit *config.Notifier[config.RateLimitConfig]",
+			"internal/handlers/handler.go":      "middleware.CORSMiddleware(h.cfg.Changes().CORS)",
+			"internal/app/app.go":               "a.logs.Levels().Reset(cfg.Level, cfg.Packages)",
+		}
+		for file, expected := range expectedContent {
+			content, err := os.ReadFile(filepath.Join(projectPath, file))
+			if err != nil {
+				t.Errorf("Failed to read %s: %v", file, err)
+				continue
+			}
+			if !strings.Contains(string(content), expected) {
+				t.Errorf("Expected %q in %s for %s", expected, file, framework)
+			}
+		}
+	}
+}
+
+func TestGenerateMigrations(t *testing.T) {
+	databases := map[string]string{
+		"PostgreSQL": "id BIGSERIAL PRIMARY KEY",
+		"MySQL":      "id BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY",
+		"In-Memory":  "id INTEGER PRIMARY KEY AUTOINCREMENT",
+		"MongoDB":    "",
+	}
+
+	for database, expectedID := range databases {
+		tempDir, err := os.MkdirTemp("", "generator-test-*")
+		if err != nil {
+			t.Fatalf("Failed to create temp dir: %v", err)
+		}
+		defer os.RemoveAll(tempDir)
+
+		projectPath := filepath.Join(tempDir, "test-project")
+		generator := New(projectPath)
+
+		config := &ProjectConfig{
+			Name:       "test-service",
+			ModuleName: "github.com/test/test-service",
+			Framework:  "gin",
+			Database:   database,
+			Path:       projectPath,
+		}
+
+		if err := generator.Generate(config); err != nil {
+			t.Fatalf("Failed to generate project with %s: %v", database, err)
+		}
+
+		if expectedID == "" {
+			if _, err := os.Stat(filepath.Join(projectPath, "migrations")); !os.IsNotExist(err) {
+				t.Errorf("migrations directory should not be created for %s", database)
+			}
+			continue
+		}
+
+		expectedContent := map[string]string{
+			"migrations/00001_create_users.sql":    expectedID,
+			"migrations/00002_create_products.sql": "-- +goose Down",
+			"migrations/migrations.go":             "//go:embed *.sql",
+			"pkg/database/migrate.go":              "goose.SetBaseFS(migrations.FS)",
+			"pkg/database/database.go":             `MigrateCommand(context.Background(), `,
+			"cmd/main.go":                          `if flag.Arg(0) == "migrate"`,
+			"Makefile":                             "migrate-new:",
+			"go.mod":                               "github.com/pressly/goose/v3",
+		}
+		for file, expected := range expectedContent {
+			content, err := os.ReadFile(filepath.Join(projectPath, file))
+			if err != nil {
+				t.Errorf("Failed to read %s: %v", file, err)
+				continue
+			}
+			if !strings.Contains(string(content), expected) {
+				t.Errorf("Expected %q in %s for %s", expected, file, database)
+			}
+		}
+	}
+}
+
+func TestGenerateRepositories(t *testing.T) {
+	databases := map[string]map[string]string{
+		"PostgreSQL": {
+			"internal/repository/user.go": "database.TranslateError(r.query().Create(user).Error)",
+			"pkg/database/errors.go":      "errors.Is(err, gorm.ErrDuplicatedKey)",
+			"pkg/database/database.go":    "TranslateError: true",
+		},
+		"MongoDB": {
+			"internal/repository/user.go": `const countersCollection = "counters"`,
+			"pkg/database/errors.go":      "mongo.IsDuplicateKeyError(err)",
+		},
+		"In-Memory": {
+			"internal/repository/user.go":      "func (r *UserRepositoryImpl) WithTx(tx database.Tx) UserRepository",
+			"internal/repository/user_test.go": "func TestUserRepositoryConflict(t *testing.T)",
+			"pkg/database/database.go":         "func (tx *SQLiteTx) DB() *gorm.DB",
+		},
+	}
+
+	for database, expectedContent := range databases {
+		tempDir, err := os.MkdirTemp("", "generator-test-*")
+		if err != nil {
+			t.Fatalf("Failed to create temp dir: %v", err)
+		}
+		defer os.RemoveAll(tempDir)
+
+		projectPath := filepath.Join(tempDir, "test-project")
+		generator := New(projectPath)
+
+		config := &ProjectConfig{
+			Name:       "test-service",
+			ModuleName: "github.com/test/test-service",
+			Framework:  "gin",
+			Database:   database,
+			Path:       projectPath,
+		}
+
+		if err := generator.Generate(config); err != nil {
+			t.Fatalf("Failed to generate project with %s: %v", database, err)
+		}
+
+		for file, expected := range expectedContent {
+			content, err := os.ReadFile(filepath.Join(projectPath, file))
+			if err != nil {
+				t.Errorf("Failed to read %s: %v", file, err)
+				continue
+			}
+			if !strings.Contains(string(content), expected) {
+				t.Errorf("Expected %q in %s for %s", expected, file, database)
+			}
+		}
+
+		repository, _ := os.ReadFile(filepath.Join(projectPath, "internal/repository/user.go"))
+		if strings.Contains(string(repository), "TODO") {
+			t.Errorf("UserRepository for %s must not contain stubs", database)
+		}
+	}
+}
+
+func TestGenerateTransactions(t *testing.T) {
+	databases := map[string]map[string]string{
+		"PostgreSQL": {
+			"pkg/database/tx.go":          "func withinSavepoint(ctx context.Context, tx Tx, fn func(ctx context.Context) error) error",
+			"pkg/database/database.go":    "return tx.tx.SavePoint(name).Error",
+			"internal/repository/user.go": "database.TxFromContext(ctx).(*database.PostgreSQLTx)",
+		},
+		"MongoDB": {
+			"pkg/database/database.go":          "t.ctx = contextWithTx(mongo.NewSessionContext(ctx, session), t)",
+			"pkg/database/interface.go":         "WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error",
+			"internal/repository/user.go":       "func (r *UserRepositoryImpl) WithContext(ctx context.Context) UserRepository",
+			"docker-compose.yml":                `command: ["--replSet", "rs0", "--bind_ip_all"]`,
+			"scripts/mongo-init-replica-set.js": `rs.initiate({ _id: "rs0", members: [{ _id: 0, host: "mongodb:27017" }] });`,
+			"config.yaml":                       "mongodb://localhost:27017/?directConnection=true",
+		},
+		// Значение флага --database в нижнем регистре
+		"mongodb": {
+			"docker-compose.yml":                "mongodb:\n        condition: service_healthy",
+			"scripts/mongo-init-replica-set.js": `rs.initiate({ _id: "rs0", members: [{ _id: 0, host: "mongodb:27017" }] });`,
+		},
+		"In-Memory": {
+			"internal/repository/user_test.go": "func TestWithinTransactionNested(t *testing.T)",
+		},
+	}
+
+	for database, expectedContent := range databases {
+		tempDir, err := os.MkdirTemp("", "generator-test-*")
+		if err != nil {
+			t.Fatalf("Failed to create temp dir: %v", err)
+		}
+		defer os.RemoveAll(tempDir)
+
+		projectPath := filepath.Join(tempDir, "test-project")
+		generator := New(projectPath)
+
+		config := &ProjectConfig{
+			Name:       "test-service",
+			ModuleName: "github.com/test/test-service",
+			Framework:  "gin",
+			Database:   database,
+			Path:       projectPath,
+		}
+
+		if err := generator.Generate(config); err != nil {
+			t.Fatalf("Failed to generate project with %s: %v", database, err)
+		}
+
+		for file, expected := range expectedContent {
+			content, err := os.ReadFile(filepath.Join(projectPath, file))
+			if err != nil {
+				t.Errorf("Failed to read %s: %v", file, err)
+				continue
+			}
+			if !strings.Contains(string(content), expected) {
+				t.Errorf("Expected %q in %s for %s", expected, file, database)
+			}
+		}
+	}
+}
+
+func TestGenerateReplicas(t *testing.T) {
+	tests := []struct {
+		database   string
+		dataAccess string
+		expected   map[string]string
+	}{
+		{
+			database: "PostgreSQL",
+			expected: map[string]string{
+				"pkg/database/replicas.go":        "func (s *replicaSet[T]) pick(ctx context.Context, primary T) T",
+				"pkg/database/replicas_test.go":   "func TestStickyPrimaryAfterWrite(t *testing.T)",
+				"pkg/database/database.go":        "func (p *PostgreSQLDatabase) Reader(ctx context.Context) *gorm.DB",
+				"pkg/database/interface.go":       "Replicas         []ReplicaStats",
+				"internal/repository/user.go":     "return r.db.Reader(ctx).WithContext(ctx)",
+				"internal/middleware/database.go": "func StickyPrimaryMiddleware() gin.HandlerFunc",
+				"internal/handlers/handler.go":    "router.Use(middleware.StickyPrimaryMiddleware())",
+				"internal/config/config.go":       "func (d DatabaseConfig) Replica(r DatabaseReplicaConfig) DatabaseConfig",
+				"internal/config/validate.go":     "func validateReplicas(v *validator, field string, replicas []DatabaseReplicaConfig)",
+				"pkg/health/checkers.go":          "func Replicas(db database.Database) []Checker",
+				"pkg/metrics/metrics.go":          `"db_replica_pool_open_connections"`,
+				"internal/app/app.go":             "for _, checker := range health.Replicas(db) {",
+				"config.yaml":                     "sticky_primary: true",
+			},
+		},
+		{
+			database:   "MySQL",
+			dataAccess: "sqlx",
+			expected: map[string]string{
+				"pkg/database/database.go":    "replicas, err := openReplicas(cfg.Database, sqlOps(open))",
+				"internal/repository/user.go": "func (r *UserRepositoryImpl) readConn() (context.Context, sqlx.ExtContext)",
+			},
+		},
+		{
+			database:   "PostgreSQL",
+			dataAccess: "pgx",
+			expected: map[string]string{
+				"pkg/database/replicas.go": "func pgxOps(open func(dbCfg config.DatabaseConfig) (*pgxpool.Pool, error)) connOps[*pgxpool.Pool]",
+				"pkg/database/database.go": "stats.Replicas = p.replicas.stats()",
+			},
+		},
+		{
+			database: "MongoDB",
+			expected: map[string]string{
+				"pkg/database/replicas.go":    "func NewDatasource(cfg *config.Config, name string) (Database, error)",
+				"pkg/database/database.go":    "clientOptions.SetReadPreference(readPref)",
+				"internal/repository/user.go": "SetReadPreference(readpref.Primary())",
+				"config.yaml":                 `read_preference: "primary"`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tempDir, err := os.MkdirTemp("", "generator-test-*")
+		if err != nil {
+			t.Fatalf("Failed to create temp dir: %v", err)
+		}
+		defer os.RemoveAll(tempDir)
+
+		projectPath := filepath.Join(tempDir, "test-project")
+		generator := New(projectPath)
+
+		config := &ProjectConfig{
+			Name:       "test-service",
+			ModuleName: "github.com/test/test-service",
+			Framework:  "gin",
+			Database:   tt.database,
+			DataAccess: tt.dataAccess,
+			Path:       projectPath,
+		}
+
+		if err := generator.Generate(config); err != nil {
+			t.Fatalf("Failed to generate project with %s: %v", tt.database, err)
+		}
+
+		for file, expected := range tt.expected {
+			content, err := os.ReadFile(filepath.Join(projectPath, file))
+			if err != nil {
+				t.Errorf("Failed to read %s: %v", file, err)
+				continue
+			}
+			if !strings.Contains(string(content), expected) {
+				t.Errorf("Expected %q in %s for %s", expected, file, tt.database)
+			}
+		}
+	}
+}
+
+func TestGenerateAdditionalDatabases(t *testing.T) {
+	tests := []struct {
+		database   string
+		dataAccess string
+		expected   map[string]string
+		absent     []string
+	}{
+		{
+			database: "CockroachDB",
+			expected: map[string]string{
+				"pkg/database/database.go":          "return withinRetryableTransaction(ctx, c, fn)",
+				"pkg/database/retry.go":             `return errors.As(err, &pgErr) && pgErr.Code == "40001"`,
+				"pkg/database/retry_test.go":        "func TestRetryOnSerializationError(t *testing.T)",
+				"pkg/database/migrate.go":           `const migrationsDialect = "postgres"`,
+				"internal/repository/user.go":       "db.(*database.CockroachDBDatabase).DB()",
+				"internal/config/config.go":         `case "cockroachdb":`,
+				"internal/config/validate.go":       `v.oneOf("database.type", db.Type, "cockroachdb")`,
+				"config.yaml":                       "port: 26257",
+				"docker-compose.yml":                "command: start-single-node --insecure",
+				"go.mod":                            "gorm.io/driver/postgres",
+				"migrations/00001_create_users.sql": "BIGSERIAL PRIMARY KEY",
+			},
+			absent: []string{"pkg/database/replicas.go"},
+		},
+		{
+			database:   "CockroachDB",
+			dataAccess: "pgx",
+			expected: map[string]string{
+				"pkg/database/database.go": "func (c *CockroachDBDatabase) Pool() *pgxpool.Pool",
+				"pkg/database/retry.go":    "func withinRetryableTransaction(",
+			},
+		},
+		{
+			database:   "cockroachdb",
+			dataAccess: "sqlc",
+			expected: map[string]string{
+				"sqlc.yaml":         `engine: "postgresql"`,
+				"queries/users.sql": "updated_at = NOW()\nWHERE id = $3",
+				"internal/repository/queries/users.sql.go": "updated_at = NOW()\nWHERE id = $3",
+			},
+		},
+		{
+			database: "ClickHouse",
+			expected: map[string]string{
+				"pkg/database/database.go":           "func (c *ClickHouseDatabase) Batch(ctx context.Context, query string, rows func(stmt *sql.Stmt) error) error",
+				"pkg/database/migrate.go":            `const migrationsDialect = "clickhouse"`,
+				"migrations/00001_create_events.sql": "ENGINE = MergeTree",
+				"internal/repository/event.go":       "func (r *EventRepositoryImpl) CountByType(since time.Time) (map[string]uint64, error)",
+				"internal/models/models.go":          "type Event struct",
+				"internal/config/config.go":          `Scheme: "clickhouse"`,
+				"config.yaml":                        "port: 9000",
+				"docker-compose.yml":                 "image: clickhouse/clickhouse-server",
+				"go.mod":                             "github.com/ClickHouse/clickhouse-go/v2",
+				".env":                               "APP_DATABASE_PASSWORD=",
+			},
+			absent: []string{"internal/repository/user.go", "migrations/00001_create_users.sql"},
+		},
+		{
+			// Значение флага --database в нижнем регистре
+			database: "cockroachdb",
+			expected: map[string]string{
+				"docker-compose.yml": "APP_DATABASE_HOST=cockroachdb",
+				"config.yaml":        "port: 26257",
+			},
+		},
+		{
+			database: "clickhouse",
+			expected: map[string]string{
+				"docker-compose.yml": "APP_DATABASE_HOST=clickhouse",
+				"config.yaml":        "port: 9000",
+			},
+		},
+		{
+			database: "SQLite",
+			expected: map[string]string{
+				"pkg/database/database.go":         `"github.com/glebarez/sqlite"`,
+				"internal/config/config.go":        `query.Add("_pragma", "journal_mode("+d.JournalMode+")")`,
+				"internal/config/validate.go":      `v.nonNegative("database.busy_timeout", db.BusyTimeout)`,
+				"internal/repository/user_test.go": `filepath.Join(t.TempDir(), "test.db")`,
+				"config.yaml":                      `journal_mode: "wal"`,
+				"docker-compose.yml":               "sqlite_data:/app/data",
+				"Dockerfile":                       "RUN mkdir -p /app/data",
+				".gitignore":                       "data/",
+				"go.mod":                           "github.com/glebarez/sqlite",
+			},
+		},
+		{
+			// Значение флага --database в нижнем регистре
+			database: "sqlite",
+			expected: map[string]string{
+				"docker-compose.yml": "sqlite_data:/app/data",
+				"Dockerfile":         "RUN mkdir -p /app/data",
+			},
+		},
+		{
+			database:   "SQLite",
+			dataAccess: "sqlx",
+			expected: map[string]string{
+				"pkg/database/database.go": `sqlx.Open("sqlite", cfg.GetDSN())`,
+				"pkg/database/errors.go":   "sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_UNIQUE",
+				"go.mod":                   "modernc.org/sqlite",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tempDir, err := os.MkdirTemp("", "generator-test-*")
+		if err != nil {
+			t.Fatalf("Failed to create temp dir: %v", err)
+		}
+		defer os.RemoveAll(tempDir)
+
+		projectPath := filepath.Join(tempDir, "test-project")
+		generator := New(projectPath)
+
+		config := &ProjectConfig{
+			Name:       "test-service",
+			ModuleName: "github.com/test/test-service",
+			Framework:  "gin",
+			Database:   tt.database,
+			DataAccess: tt.dataAccess,
+			Path:       projectPath,
+		}
+
+		if err := generator.Generate(config); err != nil {
+			t.Fatalf("Failed to generate project with %s: %v", tt.database, err)
+		}
+
+		for file, expected := range tt.expected {
+			content, err := os.ReadFile(filepath.Join(projectPath, file))
+			if err != nil {
+				t.Errorf("Failed to read %s: %v", file, err)
+				continue
+			}
+			if !strings.Contains(string(content), expected) {
+				t.Errorf("Expected %q in %s for %s", expected, file, tt.database)
+			}
+		}
+
+		for _, file := range tt.absent {
+			if _, err := os.Stat(filepath.Join(projectPath, file)); err == nil {
+				t.Errorf("%s must not be generated for %s", file, tt.database)
+			}
+		}
+	}
+}
+
+func TestGenerateDataAccess(t *testing.T) {
+	tests := []struct {
+		dataAccess string
+		database   string
+		expected   map[string]string
+	}{
+		{
+			dataAccess: "sqlx",
+			database:   "MySQL",
+			expected: map[string]string{
+				"pkg/database/database.go":    `sqlx.Open("mysql", dbCfg.DSN())`,
+				"internal/repository/user.go": "result.LastInsertId()",
+				"internal/models/models.go":   `db:"created_at"`,
+				"go.mod":                      "github.com/jmoiron/sqlx",
+			},
+		},
+		{
+			dataAccess: "pgx",
+			database:   "PostgreSQL",
+			expected: map[string]string{
+				"pkg/database/database.go":    "pgxpool.NewWithConfig(context.Background(), poolConfig)",
+				"internal/repository/user.go": "pgx.CollectRows(rows",
+				"go.mod":                      "github.com/jackc/pgx/v5",
+			},
+		},
+		{
+			dataAccess: "sqlc",
+			database:   "In-Memory",
+			expected: map[string]string{
+				"pkg/database/database.go":                 `sql.Open("sqlite3", cfg.GetDSN())`,
+				"sqlc.yaml":                                `engine: "sqlite"`,
+				"queries/users.sql":                        "-- name: CreateUser :execresult",
+				"internal/repository/queries/users.sql.go": "func (q *Queries) ListUsers(ctx context.Context, arg ListUsersParams) ([]User, error)",
+				"internal/repository/user.go":              "q.GetUserByEmail(ctx, email)",
+				"internal/repository/user_test.go":         "func TestUserRepositoryCRUD(t *testing.T)",
+				"Makefile":                                 "cmd/sqlc@v1.25.0 generate",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tempDir, err := os.MkdirTemp("", "generator-test-*")
+		if err != nil {
+			t.Fatalf("Failed to create temp dir: %v", err)
+		}
+		defer os.RemoveAll(tempDir)
+
+		projectPath := filepath.Join(tempDir, "test-project")
+		generator := New(projectPath)
+
+		config := &ProjectConfig{
+			Name:       "test-service",
+			ModuleName: "github.com/test/test-service",
+			Framework:  "gin",
+			Database:   tt.database,
+			DataAccess: tt.dataAccess,
+			EnableOTel: true,
+			Path:       projectPath,
+		}
+
+		if err := generator.Generate(config); err != nil {
+			t.Fatalf("Failed to generate project with %s: %v", tt.dataAccess, err)
+		}
+
+		for file, expected := range tt.expected {
+			content, err := os.ReadFile(filepath.Join(projectPath, file))
+			if err != nil {
+				t.Errorf("Failed to read %s: %v", file, err)
+				continue
+			}
+			if !strings.Contains(string(content), expected) {
+				t.Errorf("Expected %q in %s for %s", expected, file, tt.dataAccess)
+			}
+		}
+
+		gomod, _ := os.ReadFile(filepath.Join(projectPath, "go.mod"))
+		for _, dep := range []string{"gorm.io", "github.com/lib/pq"} {
+			if strings.Contains(string(gomod), dep) {
+				t.Errorf("%s should not be required with --data-access %s", dep, tt.dataAccess)
+			}
+		}
+	}
+}
+
+func TestGenerateMongoSchema(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "generator-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	projectPath := filepath.Join(tempDir, "test-project")
+	generator := New(projectPath)
+
+	config := &ProjectConfig{
+		Name:       "test-service",
+		ModuleName: "github.com/test/test-service",
+		Framework:  "gin",
+		Database:   "MongoDB",
+		Path:       projectPath,
+	}
+
+	if err := generator.Generate(config); err != nil {
+		t.Fatalf("Failed to generate project: %v", err)
+	}
+
+	expectedContent := map[string]string{
+		"pkg/database/migrate.go":      `const MigrationsCollection = "schema_migrations"`,
+		"pkg/database/migrate_test.go": "func TestIndexMatches(t *testing.T)",
+		"pkg/database/database.go":     `return MigrateCommand(context.Background(), m, "up")`,
+		"internal/models/models.go":    `bson:"_id,omitempty"`,
+		"config.yaml":                  "auto_migrate: true",
+		"cmd/main.go":                  `if flag.Arg(0) == "migrate"`,
+		"Makefile":                     "migrate-status:",
+	}
+	for file, expected := range expectedContent {
+		content, err := os.ReadFile(filepath.Join(projectPath, file))
+		if err != nil {
+			t.Errorf("Failed to read %s: %v", file, err)
+			continue
+		}
+		if !strings.Contains(string(content), expected) {
+			t.Errorf("Expected %q in %s", expected, file)
+		}
+	}
+
+	makefile, _ := os.ReadFile(filepath.Join(projectPath, "Makefile"))
+	if strings.Contains(string(makefile), "migrate-new:") {
+		t.Error("migrate-new should not be generated for MongoDB")
+	}
+	gomod, _ := os.ReadFile(filepath.Join(projectPath, "go.mod"))
+	if strings.Contains(string(gomod), "goose") {
+		t.Error("goose should not be required for MongoDB")
+	}
+	// Данные пользователей не переписываются при первом запуске, пример миграции только в комментарии
+	migrate, _ := os.ReadFile(filepath.Join(projectPath, "pkg/database/migrate.go"))
+	if !strings.Contains(string(migrate), "var Migrations = []Migration{}") {
+		t.Error("Migrations should be generated empty")
+	}
+}
+
+func TestGenerateSecrets(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "generator-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	projectPath := filepath.Join(tempDir, "test-project")
+	generator := New(projectPath)
+
+	config := &ProjectConfig{
+		Name:       "test-service",
+		ModuleName: "github.com/test/test-service",
+		Framework:  "gin",
+		Database:   "MySQL",
+		EnableAuth: true,
+		Path:       projectPath,
+	}
+
+	if err := generator.Generate(config); err != nil {
+		t.Fatalf("Failed to generate project: %v", err)
+	}
+
+	expectedContent := map[string]string{
+		".gitignore":                      ".env",
+		"docker-compose.yml":              "MYSQL_ROOT_PASSWORD: ${APP_DATABASE_PASSWORD:?",
+		"internal/config/secrets.go":      "type SecretProvider interface",
+		"internal/config/env.go":          `os.LookupEnv(key + "_FILE")`,
+		"internal/config/config.go":       "resolveSecrets(context.Background(), config, options.secrets)",
+		"internal/config/secrets_test.go": "func TestFileProviderAndEnvFile(",
+	}
+	for file, expected := range expectedContent {
+		content, err := os.ReadFile(filepath.Join(projectPath, file))
+		if err != nil {
+			t.Errorf("Failed to read %s: %v", file, err)
+			continue
+		}
+		if !strings.Contains(string(content), expected) {
+			t.Errorf("Expected %q in %s", expected, file)
+		}
+	}
+
+	for _, file := range []string{"config.yaml", "docker-compose.yml"} {
+		content, _ := os.ReadFile(filepath.Join(projectPath, file))
+		if strings.Contains(string(content), `password: "password"`) || strings.Contains(string(content), "PASSWORD: password") {
+			t.Errorf("%s must not contain plaintext passwords", file)
+		}
+	}
+
+	env, err := os.ReadFile(filepath.Join(projectPath, ".env"))
+	if err != nil {
+		t.Fatalf("Failed to read .env: %v", err)
+	}
+	for _, name := range []string{"APP_DATABASE_PASSWORD=", "APP_AUTH_SECRET=", "APP_ADMIN_TOKEN="} {
+		line := ""
+		for _, l := range strings.Split(string(env), "\n") {
+			if strings.HasPrefix(l, name) {
+				line = l
+			}
+		}
+		if len(strings.TrimPrefix(line, name)) != 32 {
+			t.Errorf("Expected random 32 char value for %s in .env, got %q", name, line)
+		}
+	}
+}
+
+func TestGenerateLoggerSinks(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "generator-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	projectPath := filepath.Join(tempDir, "test-project")
+	generator := New(projectPath)
+
+	config := &ProjectConfig{
+		Name:       "test-service",
+		ModuleName: "github.com/test/test-service",
+		Framework:  "fiber",
+		Database:   "Без БД",
+		Logger:     "zap",
+		Path:       projectPath,
+	}
+
+	if err := generator.Generate(config); err != nil {
+		t.Fatalf("Failed to generate project: %v", err)
+	}
+
+	expectedContent := map[string]string{
+		"pkg/logger/logger.go":    "func New(config LoggerConfig) *Root",
+		"pkg/logger/levels.go":    "func (l *Levels) Handler() http.Handler",
+		"pkg/logger/zap.go":       "func newLogger(opts sinkOptions) Logger",
+		"internal/admin/admin.go": `mux.Handle("/log/level", levels.Handler())`,
+		"internal/app/app.go":     "admin.New(a.cfg, a.build, a.logs.Levels())",
+		"config.yaml":             "max_size_mb:",
+		"go.mod":                  "gopkg.in/natefinch/lumberjack.v2",
+	}
+	for file, expected := range expectedContent {
+		content, err := os.ReadFile(filepath.Join(projectPath, file))
+		if err != nil {
+			t.Errorf("Failed to read %s: %v", file, err)
+			continue
+		}
+		if !strings.Contains(string(content), expected) {
+			t.Errorf("Expected %q in %s", expected, file)
+		}
+	}
+}
+
+func TestGenerateAdmin(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "generator-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	projectPath := filepath.Join(tempDir, "test-project")
+	generator := New(projectPath)
+
+	config := &ProjectConfig{
+		Name:       "test-service",
+		ModuleName: "github.com/test/test-service",
+		Framework:  "echo",
+		Database:   "postgresql",
+		Path:       projectPath,
+	}
+
+	if err := generator.Generate(config); err != nil {
+		t.Fatalf("Failed to generate project: %v", err)
+	}
+
+	expectedContent := map[string]string{
+		"internal/admin/admin.go":      `mux.HandleFunc("/debug/pprof/", pprof.Index)`,
+		"internal/admin/admin_test.go": "func TestTokenRequired(",
+		"internal/config/config.go":    "func (c *Config) Redacted() (map[string]interface{}, error)",
+		"cmd/main.go":                  `version   = "dev"`,
+		"Makefile":                     "-X main.version=$(VERSION) -X main.commit=$(COMMIT)",
+		"Dockerfile":                   "ARG VERSION=dev",
+		"config.yaml":                  `token: ""`,
+	}
+	for file, expected := range expectedContent {
+		content, err := os.ReadFile(filepath.Join(projectPath, file))
+		if err != nil {
+			t.Errorf("Failed to read %s: %v", file, err)
+			continue
+		}
+		if !strings.Contains(string(content), expected) {
+			t.Errorf("Expected %q in %s", expected, file)
+		}
+	}
+}
+
+func TestGenerateLoggerBackends(t *testing.T) {
+	backends := map[string]string{
+		"":        "github.com/sirupsen/logrus",
+		"slog":    "log/slog",
+		"zap":     "go.uber.org/zap",
+		"zerolog": "github.com/rs/zerolog",
+	}
+
+	for backend, expectedImport := range backends {
+		tempDir, err := os.MkdirTemp("", "generator-test-*")
+		if err != nil {
+			t.Fatalf("Failed to create temp dir: %v", err)
+		}
+		defer os.RemoveAll(tempDir)
+
+		projectPath := filepath.Join(tempDir, "test-project")
+		generator := New(projectPath)
+
+		config := &ProjectConfig{
+			Name:       "test-service",
+			ModuleName: "github.com/test/test-service",
+			Framework:  "gin",
+			Database:   "Без БД",
+			Logger:     backend,
+			Path:       projectPath,
+		}
+
+		if err := generator.Generate(config); err != nil {
+			t.Fatalf("Failed to generate project with logger %q: %v", backend, err)
+		}
+
+		name := backend
+		if name == "" {
+			name = "logrus"
+		}
+		content, err := os.ReadFile(filepath.Join(projectPath, "pkg/logger", name+".go"))
+		if err != nil {
+			t.Errorf("Failed to read logger implementation for %q: %v", name, err)
+			continue
+		}
+		if !strings.Contains(string(content), expectedImport) {
+			t.Errorf("Expected %q import in %s.go", expectedImport, name)
 		}
 
-		// Проверяем, что конфигурация не пустая
-		if config.Database == "" {
-			t.Errorf("Database configuration should not be empty for %s", db)
+		goMod, _ := os.ReadFile(filepath.Join(projectPath, "go.mod"))
+		if name != "logrus" && strings.Contains(string(goMod), "logrus") {
+			t.Errorf("go.mod should not require logrus for logger %q", name)
 		}
 	}
 }
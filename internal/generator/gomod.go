@@ -35,28 +35,47 @@ func (g *Generator) generateGoMod(config *ProjectConfig) error {
 	}
 
 	// Добавляем зависимости для БД
+	switch dataAccess(config) {
+	case "gorm":
+		dependencies = append(dependencies, "gorm.io/gorm v1.25.5")
+		switch strings.ToLower(config.Database) {
+		case "postgresql":
+			dependencies = append(dependencies, "gorm.io/driver/postgres v1.5.4")
+		case "cockroachdb":
+			// pgconn нужен для проверки ошибок сериализации в повторе транзакций
+			dependencies = append(dependencies,
+				"github.com/jackc/pgx/v5 v5.5.1",
+				"gorm.io/driver/postgres v1.5.4",
+			)
+		case "mysql":
+			dependencies = append(dependencies,
+				"github.com/go-sql-driver/mysql v1.7.1",
+				"gorm.io/driver/mysql v1.5.2",
+			)
+		case "in-memory":
+			dependencies = append(dependencies, "gorm.io/driver/sqlite v1.5.4")
+		case "sqlite":
+			// Драйвер на modernc.org/sqlite без cgo
+			dependencies = append(dependencies, "github.com/glebarez/sqlite v1.10.0")
+		}
+	case "sqlx":
+		dependencies = append(dependencies, "github.com/jmoiron/sqlx v1.3.5", sqlDriverDependency(config))
+	case "sqlc":
+		dependencies = append(dependencies, sqlDriverDependency(config))
+	case "pgx":
+		dependencies = append(dependencies, "github.com/jackc/pgx/v5 v5.5.1")
+	}
+
 	switch strings.ToLower(config.Database) {
-	case "postgresql":
-		dependencies = append(dependencies,
-			"github.com/lib/pq v1.10.9",
-			"gorm.io/gorm v1.25.5",
-			"gorm.io/driver/postgres v1.5.4",
-		)
-	case "mysql":
-		dependencies = append(dependencies,
-			"github.com/go-sql-driver/mysql v1.7.1",
-			"gorm.io/gorm v1.25.5",
-			"gorm.io/driver/mysql v1.5.2",
-		)
 	case "mongodb":
-		dependencies = append(dependencies,
-			"go.mongodb.org/mongo-driver v1.13.1",
-		)
-	case "in-memory":
-		dependencies = append(dependencies,
-			"gorm.io/gorm v1.25.5",
-			"gorm.io/driver/sqlite v1.5.4",
-		)
+		dependencies = append(dependencies, "go.mongodb.org/mongo-driver v1.13.1")
+	case "clickhouse":
+		dependencies = append(dependencies, "github.com/ClickHouse/clickhouse-go/v2 v2.20.0")
+	}
+
+	// Добавляем goose для SQL миграций
+	if hasSQLMigrations(config) {
+		dependencies = append(dependencies, "github.com/pressly/goose/v3 v3.17.0")
 	}
 
 	// Добавляем gRPC зависимости если включен
@@ -67,10 +86,48 @@ func (g *Generator) generateGoMod(config *ProjectConfig) error {
 		)
 	}
 
+	// Добавляем зависимости аутентификации если включена
+	if config.EnableAuth {
+		dependencies = append(dependencies,
+			"github.com/golang-jwt/jwt/v5 v5.2.0",
+		)
+	}
+
+	// Добавляем зависимости OpenTelemetry если включена трассировка
+	if config.EnableOTel {
+		dependencies = append(dependencies,
+			"go.opentelemetry.io/otel v1.21.0",
+			"go.opentelemetry.io/otel/sdk v1.21.0",
+			"go.opentelemetry.io/otel/trace v1.21.0",
+			"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc v1.21.0",
+			"go.opentelemetry.io/otel/exporters/stdout/stdouttrace v1.21.0",
+		)
+		if config.EnableGRPC {
+			dependencies = append(dependencies,
+				"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc v0.46.1",
+			)
+		}
+		switch {
+		case dataAccess(config) == "gorm":
+			dependencies = append(dependencies, "gorm.io/plugin/opentelemetry v0.1.8")
+		case strings.ToLower(config.Database) == "mongodb":
+			dependencies = append(dependencies,
+				"go.opentelemetry.io/contrib/instrumentation/go.mongodb.org/mongo-driver/mongo/otelmongo v0.46.1",
+			)
+		}
+	}
+
+	// Добавляем зависимость выбранного логгера
+	if dep := loggerDependency(config); dep != "" {
+		dependencies = append(dependencies, dep)
+	}
+
 	// Общие зависимости
 	dependencies = append(dependencies,
-		"github.com/sirupsen/logrus v1.9.3",
 		"github.com/joho/godotenv v1.4.0",
+		"github.com/redis/go-redis/v9 v9.3.0",
+		"github.com/prometheus/client_golang v1.17.0",
+		"gopkg.in/natefinch/lumberjack.v2 v2.2.1",
 	)
 
 	// Формируем содержимое go.mod файла
@@ -91,3 +148,17 @@ require (
 	goModPath := filepath.Join(g.projectPath, "go.mod")
 	return os.WriteFile(goModPath, []byte(content), 0644)
 }
+
+// sqlDriverDependency возвращает драйвер database/sql для sqlx и sqlc
+func sqlDriverDependency(config *ProjectConfig) string {
+	switch strings.ToLower(config.Database) {
+	case "mysql":
+		return "github.com/go-sql-driver/mysql v1.7.1"
+	case "in-memory":
+		return "github.com/mattn/go-sqlite3 v1.14.17"
+	case "sqlite":
+		return "modernc.org/sqlite v1.23.1"
+	default:
+		return "github.com/jackc/pgx/v5 v5.5.1"
+	}
+}
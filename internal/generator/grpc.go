@@ -18,6 +18,11 @@ func (g *Generator) generateGRPC(config *ProjectConfig) error {
 		return err
 	}
 
+	// Создаем цепочку interceptors
+	if err := g.generateGRPCInterceptors(config); err != nil {
+		return err
+	}
+
 	// Создаем gRPC клиент (для примера)
 	if err := g.generateGRPCClient(config); err != nil {
 		return err
@@ -150,27 +155,60 @@ import (
 	"time"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/reflection"`+otelgrpcImport(config)+`
 
 	"%s/internal/config"
 	"%s/internal/grpc/pb"
 	"%s/pkg/logger"
+	"%s/pkg/metrics"
+	"%s/pkg/tlsutil"`, config.ModuleName, config.ModuleName, config.ModuleName, config.ModuleName, config.ModuleName)
+
+	if config.EnableAuth {
+		content += fmt.Sprintf(`
+	"%s/pkg/auth"`, config.ModuleName)
+	}
+
+	content += `
 )
 
 // Server представляет gRPC сервер
 type Server struct {
-	cfg      *config.Config
-	logger   logger.Logger
-	grpcSrv  *grpc.Server
-	listener net.Listener
+	cfg       *config.Config
+	logger    logger.Logger
+	grpcSrv   *grpc.Server
+	listener  net.Listener
+	stopWatch context.CancelFunc
+	registry  *metrics.Registry`
+
+	if config.EnableAuth {
+		content += `
+	verifier  *auth.Verifier`
+	}
+
+	content += fmt.Sprintf(`
 	pb.Unimplemented%sServiceServer
 }
 
 // New создает новый gRPC сервер
-func New(cfg *config.Config, logger logger.Logger) *Server {
+func New(cfg *config.Config, logger logger.Logger`, config.Name)
+
+	if config.EnableAuth {
+		content += `, verifier *auth.Verifier`
+	}
+
+	content += `, registry *metrics.Registry) *Server {
 	return &Server{
-		cfg:    cfg,
-		logger: logger,
+		cfg:      cfg,
+		logger:   logger,
+		registry: registry,`
+
+	if config.EnableAuth {
+		content += `
+		verifier: verifier,`
+	}
+
+	content += fmt.Sprintf(`
 	}
 }
 
@@ -183,10 +221,28 @@ func (s *Server) Start() error {
 
 	s.listener = lis
 
+	opts := []grpc.ServerOption{
+		grpc.ConnectionTimeout(time.Duration(s.cfg.GRPC.MaxConnectionAge) * time.Second),
+		grpc.ChainUnaryInterceptor(s.unaryInterceptors()...),
+		grpc.ChainStreamInterceptor(s.streamInterceptors()...),`+grpcStatsHandler(config, "grpc.StatsHandler(otelgrpc.NewServerHandler())")+`
+	}
+
+	// Включаем TLS/mTLS если настроен
+	if s.cfg.GRPC.TLS.Enabled {
+		reloader, err := tlsutil.NewReloader(s.cfg.GRPC.TLS, s.logger)
+		if err != nil {
+			return fmt.Errorf("ошибка настройки TLS: %%w", err)
+		}
+
+		watchCtx, stopWatch := context.WithCancel(context.Background())
+		s.stopWatch = stopWatch
+		go reloader.Watch(watchCtx, 10*time.Second)
+
+		opts = append(opts, grpc.Creds(credentials.NewTLS(reloader.ServerConfig())))
+	}
+
 	// Создаем gRPC сервер
-	s.grpcSrv = grpc.NewServer(
-		grpc.ConnectionTimeout(time.Duration(s.cfg.GRPC.MaxConnectionAge)*time.Second),
-	)
+	s.grpcSrv = grpc.NewServer(opts...)
 
 	// Регистрируем сервис
 	pb.Register%sServiceServer(s.grpcSrv, s)
@@ -194,7 +250,7 @@ func (s *Server) Start() error {
 	// Включаем reflection для grpcurl
 	reflection.Register(s.grpcSrv)
 
-	s.logger.Info("gRPC сервер запущен", "port", s.cfg.GRPC.Port)
+	s.logger.Info("gRPC сервер запущен", "port", s.cfg.GRPC.Port, "tls", s.cfg.GRPC.TLS.Enabled)
 
 	return s.grpcSrv.Serve(lis)
 }
@@ -204,6 +260,9 @@ func (s *Server) Stop() {
 	if s.grpcSrv != nil {
 		s.grpcSrv.GracefulStop()
 	}
+	if s.stopWatch != nil {
+		s.stopWatch()
+	}
 }
 
 // HealthCheck реализует health check
@@ -321,7 +380,7 @@ func (s *Server) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.L
 		Total: int32(len(users)),
 	}, nil
 }
-`, config.ModuleName, config.ModuleName, config.ModuleName, config.Name, config.Name)
+`, config.Name)
 
 	// Создаем директорию для gRPC
 	grpcDir := filepath.Join(g.projectPath, "internal/grpc")
@@ -333,17 +392,364 @@ func (s *Server) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.L
 	return os.WriteFile(serverPath, []byte(content), 0644)
 }
 
+// stickyPrimaryInterceptors генерирует interceptors sticky primary, если есть реплики или read preference
+func stickyPrimaryInterceptors(config *ProjectConfig) string {
+	if !hasReadRouting(config) {
+		return ""
+	}
+	return `
+// StickyPrimaryUnaryInterceptor задает границы вызова для sticky primary: после записи в БД
+// чтения этого вызова идут в primary
+func StickyPrimaryUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(database.WithStickyPrimary(ctx), req)
+	}
+}
+
+// StickyPrimaryStreamInterceptor задает границы stream вызова для sticky primary
+func StickyPrimaryStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &wrappedStream{ServerStream: ss, ctx: database.WithStickyPrimary(ss.Context())})
+	}
+}
+`
+}
+
+// generateGRPCInterceptors создает internal/grpc/interceptors.go с цепочкой interceptors
+func (g *Generator) generateGRPCInterceptors(config *ProjectConfig) error {
+	content := `package grpc
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"`
+
+	if config.EnableAuth {
+		content += `
+	"google.golang.org/grpc/codes"`
+	}
+
+	content += `
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+`
+
+	if config.EnableAuth {
+		content += fmt.Sprintf(`
+	"%s/pkg/auth"`, config.ModuleName)
+	}
+
+	content += fmt.Sprintf(`
+	appcontext "%s/pkg/context"`, config.ModuleName)
+
+	if hasReadRouting(config) {
+		content += fmt.Sprintf(`
+	"%s/pkg/database"`, config.ModuleName)
+	}
+
+	content += fmt.Sprintf(`
+	"%s/pkg/logger"
+	"%s/pkg/metrics"
+	"%s/pkg/requestid"`, config.ModuleName, config.ModuleName, config.ModuleName)
+
+	if config.EnableOTel {
+		content += fmt.Sprintf(`
+	"%s/pkg/tracing"`, config.ModuleName)
+	}
+
+	content += `
+)
+
+// unaryInterceptors возвращает цепочку unary interceptors сервера
+func (s *Server) unaryInterceptors() []grpc.UnaryServerInterceptor {
+	var interceptors []grpc.UnaryServerInterceptor
+	if s.registry != nil {
+		interceptors = append(interceptors, MetricsUnaryInterceptor(s.registry))
+	}`
+
+	if hasReadRouting(config) {
+		content += `
+	if s.cfg.Database.StickyPrimary {
+		interceptors = append(interceptors, StickyPrimaryUnaryInterceptor())
+	}`
+	}
+
+	content += `
+	interceptors = append(interceptors, RequestIDUnaryInterceptor(s.logger))`
+
+	if config.EnableAuth {
+		content += `
+
+	if s.verifier != nil {
+		interceptors = append(interceptors, AuthUnaryInterceptor(s.verifier, s.logger))
+	}`
+	}
+
+	content += `
+
+	return interceptors
+}
+
+// streamInterceptors возвращает цепочку stream interceptors сервера
+func (s *Server) streamInterceptors() []grpc.StreamServerInterceptor {
+	var interceptors []grpc.StreamServerInterceptor
+	if s.registry != nil {
+		interceptors = append(interceptors, MetricsStreamInterceptor(s.registry))
+	}`
+
+	if hasReadRouting(config) {
+		content += `
+	if s.cfg.Database.StickyPrimary {
+		interceptors = append(interceptors, StickyPrimaryStreamInterceptor())
+	}`
+	}
+
+	content += `
+	interceptors = append(interceptors, RequestIDStreamInterceptor(s.logger))`
+
+	if config.EnableAuth {
+		content += `
+
+	if s.verifier != nil {
+		interceptors = append(interceptors, AuthStreamInterceptor(s.verifier, s.logger))
+	}`
+	}
+
+	content += `
+
+	return interceptors
+}
+
+// wrappedStream позволяет interceptors подменять контекст stream
+type wrappedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context возвращает контекст stream
+func (w *wrappedStream) Context() context.Context {
+	return w.ctx
+}
+
+// MetricsUnaryInterceptor записывает количество и длительность unary вызовов по методу и коду
+func MetricsUnaryInterceptor(registry *metrics.Registry) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		registry.ObserveGRPC(info.FullMethod, status.Code(err).String(), time.Since(start))
+		return resp, err
+	}
+}
+
+// MetricsStreamInterceptor записывает количество и длительность stream вызовов
+func MetricsStreamInterceptor(registry *metrics.Registry) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		registry.ObserveGRPC(info.FullMethod, status.Code(err).String(), time.Since(start))
+		return err
+	}
+}
+
+` + stickyPrimaryInterceptors(config) + `
+// RequestIDUnaryInterceptor читает или создает request ID и возвращает его в заголовках ответа
+func RequestIDUnaryInterceptor(log logger.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, id := incomingRequestID(ctx, log)
+		if err := grpc.SetHeader(ctx, metadata.Pairs(requestid.MetadataKey, id)); err != nil {
+			log.WithContext(ctx).Warn("Не удалось установить request ID в ответ", "method", info.FullMethod, "error", err)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// RequestIDStreamInterceptor читает или создает request ID для stream вызовов
+func RequestIDStreamInterceptor(log logger.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, id := incomingRequestID(ss.Context(), log)
+		if err := ss.SetHeader(metadata.Pairs(requestid.MetadataKey, id)); err != nil {
+			log.WithContext(ctx).Warn("Не удалось установить request ID в ответ", "method", info.FullMethod, "error", err)
+		}
+		return handler(srv, &wrappedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// incomingRequestID берет request ID из метаданных или создает новый и сохраняет его в AppContext
+func incomingRequestID(ctx context.Context, log logger.Logger) (context.Context, string) {
+	var id string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(requestid.MetadataKey); len(values) > 0 {
+			id = requestid.Sanitize(values[0])
+		}
+	}
+` + grpcNewRequestID(config) + `
+	return appcontext.ToContext(ctx, appCtx.WithTraceID(id)), id
+}
+
+// RequestIDUnaryClientInterceptor пробрасывает request ID из контекста в исходящие вызовы
+func RequestIDUnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(outgoingRequestID(ctx), method, req, reply, cc, opts...)
+	}
+}
+
+// RequestIDStreamClientInterceptor пробрасывает request ID из контекста в исходящие stream вызовы
+func RequestIDStreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(outgoingRequestID(ctx), desc, cc, method, opts...)
+	}
+}
+
+// outgoingRequestID добавляет request ID в исходящие метаданные, если он еще не задан
+func outgoingRequestID(ctx context.Context) context.Context {
+	id := requestid.FromContext(ctx)
+	if id == "" {
+		return ctx
+	}
+	if md, ok := metadata.FromOutgoingContext(ctx); ok && len(md.Get(requestid.MetadataKey)) > 0 {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, requestid.MetadataKey, id)
+}
+`
+
+	if config.EnableAuth {
+		content += fmt.Sprintf(`
+// publicMethods методы, доступные без токена
+var publicMethods = map[string]bool{
+	"/%s.%sService/HealthCheck":                                 true,
+	"/%s.%sService/Ping":                                        true,
+	"/grpc.reflection.v1.ServerReflection/ServerReflectionInfo":      true,
+	"/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo": true,
+}
+
+// methodScopes обязательные scopes для методов, например:
+// "/%s.%sService/DeleteUser": {"users:write"}
+var methodScopes = map[string][]string{}
+
+// AuthUnaryInterceptor проверяет Bearer токен из метаданных для unary вызовов
+func AuthUnaryInterceptor(verifier *auth.Verifier, log logger.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := authenticate(ctx, verifier, log, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// AuthStreamInterceptor проверяет Bearer токен из метаданных для stream вызовов
+func AuthStreamInterceptor(verifier *auth.Verifier, log logger.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticate(ss.Context(), verifier, log, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &wrappedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// authenticate проверяет токен и scopes метода, сохраняет пользователя в AppContext
+func authenticate(ctx context.Context, verifier *auth.Verifier, log logger.Logger, method string) (context.Context, error) {
+	if publicMethods[method] {
+		return ctx, nil
+	}
+
+	var header string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("authorization"); len(values) > 0 {
+			header = values[0]
+		}
+	}
+
+	claims, err := verifier.Verify(ctx, auth.TokenFromHeader(header))
+	if err != nil {
+		log.WithContext(ctx).Debug("Отказ в аутентификации gRPC", "method", method, "error", err)
+		return nil, status.Error(codes.Unauthenticated, auth.ErrInvalidToken.Error())
+	}
+
+	if !claims.HasScopes(methodScopes[method]...) {
+		return nil, status.Error(codes.PermissionDenied, auth.ErrInsufficientScope.Error())
+	}
+
+	appCtx, ok := appcontext.FromContext(ctx)
+	if !ok {
+		appCtx = appcontext.New(ctx, log)
+	}
+	appCtx = appCtx.WithUserID(claims.Subject).WithRoles(claims.Roles)
+
+	return appcontext.ToContext(auth.WithClaims(ctx, claims), appCtx), nil
+}
+`, config.Name, config.Name, config.Name, config.Name, config.Name, config.Name)
+	}
+
+	interceptorsPath := filepath.Join(g.projectPath, "internal/grpc/interceptors.go")
+	return os.WriteFile(interceptorsPath, []byte(content), 0644)
+}
+
+// otelgrpcImport возвращает импорт otelgrpc, если включен --otel
+func otelgrpcImport(config *ProjectConfig) string {
+	if !config.EnableOTel {
+		return ""
+	}
+	return `
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"`
+}
+
+// grpcStatsHandler возвращает опцию трассировки gRPC, если включен --otel
+func grpcStatsHandler(config *ProjectConfig, option string) string {
+	if !config.EnableOTel {
+		return ""
+	}
+	return `
+		` + option + `,`
+}
+
+// grpcNewRequestID генерирует получение request ID и AppContext в gRPC interceptor
+func grpcNewRequestID(config *ProjectConfig) string {
+	if !config.EnableOTel {
+		return `	if id == "" {
+		id = requestid.New()
+	}
+
+	ctx = requestid.NewContext(ctx, id)
+	appCtx, ok := appcontext.FromContext(ctx)
+	if !ok {
+		appCtx = appcontext.New(ctx, log)
+	}
+`
+	}
+
+	return `	if id == "" {
+		id = tracing.TraceID(ctx)
+	}
+	if id == "" {
+		id = requestid.New()
+	}
+
+	ctx = requestid.NewContext(ctx, id)
+	tracing.AnnotateRequestID(ctx, id)
+
+	appCtx, ok := appcontext.FromContext(ctx)
+	if !ok {
+		appCtx = appcontext.New(ctx, log.WithContext(ctx))
+	}
+`
+}
+
 // generateGRPCClient создает gRPC клиент (для примера)
 func (g *Generator) generateGRPCClient(config *ProjectConfig) error {
 	content := fmt.Sprintf(`package grpc
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
-	"time"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"`+otelgrpcImport(config)+`
 
 	"%s/internal/grpc/pb"
 	"%s/pkg/logger"
@@ -356,9 +762,20 @@ type Client struct {
 	logger logger.Logger
 }
 
-// NewClient создает новый gRPC клиент
-func NewClient(address string, logger logger.Logger) (*Client, error) {
-	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+// NewClient создает новый gRPC клиент.
+// Если tlsConfig равен nil, соединение устанавливается без шифрования
+// (см. tlsutil.NewClientConfig для TLS/mTLS)
+func NewClient(address string, tlsConfig *tls.Config, logger logger.Logger) (*Client, error) {
+	creds := insecure.NewCredentials()
+	if tlsConfig != nil {
+		creds = credentials.NewTLS(tlsConfig)
+	}
+
+	conn, err := grpc.Dial(address,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithChainUnaryInterceptor(RequestIDUnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(RequestIDStreamClientInterceptor()),`+grpcStatsHandler(config, "grpc.WithStatsHandler(otelgrpc.NewClientHandler())")+`
+	)
 	if err != nil {
 		return nil, fmt.Errorf("ошибка подключения к gRPC серверу: %%w", err)
 	}
@@ -43,7 +43,172 @@ func (g *Generator) generateBaseHandler(config *ProjectConfig) error {
 	}
 
 	handlerPath := filepath.Join(g.projectPath, "internal/handlers/handler.go")
-	return os.WriteFile(handlerPath, []byte(content), 0644)
+	if err := os.WriteFile(handlerPath, []byte(content), 0644); err != nil {
+		return err
+	}
+
+	handlerTestPath := filepath.Join(g.projectPath, "internal/handlers/handler_test.go")
+	return os.WriteFile(handlerTestPath, []byte(g.generateHandlerTest(config)), 0644)
+}
+
+// generateHandlerTest генерирует помощники тестов маршрутов и тесты определения IP клиента для rate limiting
+func (g *Generator) generateHandlerTest(config *ProjectConfig) string {
+	// peer адрес, с которого приходят тестовые запросы
+	frameworkImport, peer, serve := "", "192.0.2.1", ""
+
+	switch strings.ToLower(config.Framework) {
+	case "fiber":
+		// app.Test не использует RemoteAddr и всегда подключается с 0.0.0.0
+		frameworkImport, peer = `"github.com/gofiber/fiber/v2"`, "0.0.0.0"
+		serve = `
+// serve выполняет запрос и возвращает код ответа
+func serve(t *testing.T, app *fiber.App, req *http.Request) int {
+	t.Helper()
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+	return resp.StatusCode
+}
+`
+	case "echo":
+		frameworkImport = `"github.com/labstack/echo/v4"`
+		serve = `
+// serve выполняет запрос и возвращает код ответа
+func serve(t *testing.T, e *echo.Echo, req *http.Request) int {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec.Code
+}
+`
+	default:
+		frameworkImport = `"github.com/gin-gonic/gin"`
+		serve = `
+// serve выполняет запрос и возвращает код ответа
+func serve(t *testing.T, router *gin.Engine, req *http.Request) int {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	return rec.Code
+}
+`
+	}
+
+	content := `package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	` + frameworkImport + `
+
+	"` + config.ModuleName + `/internal/config"`
+
+	if config.EnableAuth {
+		content += `
+	"` + config.ModuleName + `/pkg/auth"`
+	}
+
+	content += `
+	"` + config.ModuleName + `/pkg/health"
+	"` + config.ModuleName + `/pkg/logger"
+	"` + config.ModuleName + `/pkg/ratelimit"
+)
+`
+
+	content += `
+// peer адрес, с которого приходят тестовые запросы
+const peer = "` + peer + `"
+`
+
+	if config.EnableAuth {
+		content += `
+const testSecret = "test-secret"
+`
+	}
+
+	content += `
+// newTestHandler создает Handler с лимитом в один запрос на клиента, клиент определяется по keyBy
+func newTestHandler(t *testing.T, keyBy string, trustedProxies ...string) *Handler {
+	t.Helper()
+
+	cfg := &config.Config{}
+	cfg.App.TrustedProxies = trustedProxies
+	cfg.Middleware.RateLimit = config.RateLimitConfig{
+		Enabled: true,
+		KeyBy:   keyBy,
+		Default: config.RateLimitRule{Requests: 1, Window: 60},
+	}
+
+	limiter := ratelimit.NewMemoryLimiter()
+	t.Cleanup(func() { limiter.Close() })
+
+	h := &Handler{
+		cfg:     cfg,
+		logger:  logger.New(logger.LoggerConfig{Level: "error"}),
+		limiter: limiter,
+		checks:  health.New(cfg.Health),
+	}`
+
+	if config.EnableAuth {
+		content += `
+
+	cfg.Auth = config.AuthConfig{Enabled: true, Secret: testSecret}
+	verifier, err := auth.NewVerifier(cfg.Auth)
+	if err != nil {
+		t.Fatalf("NewVerifier failed: %v", err)
+	}
+	h.verifier = verifier`
+	}
+
+	content += `
+	return h
+}
+
+// newRequest создает GET запрос, пришедший с адреса peer
+func newRequest(t *testing.T, path string) *http.Request {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	req.RemoteAddr = peer + ":1234"
+	return req
+}
+
+// forwardedFor добавляет заголовки с адресом клиента, которые выставляет прокси
+func forwardedFor(req *http.Request, ip string) *http.Request {
+	req.Header.Set("X-Forwarded-For", ip)
+	req.Header.Set("X-Real-IP", ip)
+	return req
+}
+` + serve + `
+func TestRateLimitIgnoresSpoofedForwardedFor(t *testing.T) {
+	router := newTestHandler(t, "ip").SetupRoutes()
+
+	if code := serve(t, router, forwardedFor(newRequest(t, "/api/v1/ping"), "198.51.100.1")); code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", code)
+	}
+	if code := serve(t, router, forwardedFor(newRequest(t, "/api/v1/ping"), "198.51.100.2")); code != http.StatusTooManyRequests {
+		t.Errorf("X-Forwarded-For from an untrusted peer must not change the client, got %d", code)
+	}
+}
+
+func TestRateLimitTrustedProxy(t *testing.T) {
+	router := newTestHandler(t, "ip", peer).SetupRoutes()
+
+	for _, client := range []string{"198.51.100.1", "198.51.100.2"} {
+		if code := serve(t, router, forwardedFor(newRequest(t, "/api/v1/ping"), client)); code != http.StatusOK {
+			t.Fatalf("%s: expected 200, got %d", client, code)
+		}
+	}
+	if code := serve(t, router, forwardedFor(newRequest(t, "/api/v1/ping"), "198.51.100.1")); code != http.StatusTooManyRequests {
+		t.Errorf("expected 429 for the client behind the trusted proxy, got %d", code)
+	}
+}
+`
+
+	return content
 }
 
 // generateGinHandler генерирует handler для Gin
@@ -56,7 +221,21 @@ import (
 	ginSwagger "github.com/swaggo/gin-swagger"
 
 	"%s/internal/config"
-	"%s/pkg/logger"`, config.ModuleName, config.ModuleName)
+	"%s/internal/middleware"
+	"%s/pkg/health"
+	"%s/pkg/logger"
+	"%s/pkg/metrics"
+	"%s/pkg/ratelimit"`, config.ModuleName, config.ModuleName, config.ModuleName, config.ModuleName, config.ModuleName, config.ModuleName)
+
+	if config.EnableAuth {
+		content += fmt.Sprintf(`
+	"%s/pkg/auth"`, config.ModuleName)
+	}
+
+	if hasCache(config) {
+		content += fmt.Sprintf(`
+	"%s/pkg/cache"`, config.ModuleName)
+	}
 
 	if !strings.Contains(strings.ToLower(config.Database), "без") {
 		content += fmt.Sprintf(`
@@ -76,7 +255,20 @@ type Handler struct {
 	db     database.Database`
 	}
 
+	if config.EnableAuth {
+		content += `
+	verifier *auth.Verifier`
+	}
+
+	if hasCache(config) {
+		content += `
+	cache    cache.Cache`
+	}
+
 	content += `
+	limiter  ratelimit.Limiter
+	registry *metrics.Registry
+	checks   *health.Registry
 }
 
 // New создает новый handler
@@ -86,7 +278,15 @@ func New(cfg *config.Config, logger logger.Logger`
 		content += `, db database.Database`
 	}
 
-	content += `) *Handler {
+	if config.EnableAuth {
+		content += `, verifier *auth.Verifier`
+	}
+
+	if hasCache(config) {
+		content += `, store cache.Cache`
+	}
+
+	content += `, limiter ratelimit.Limiter, registry *metrics.Registry, checks *health.Registry) *Handler {
 	return &Handler{
 		cfg:    cfg,
 		logger: logger,`
@@ -96,7 +296,20 @@ func New(cfg *config.Config, logger logger.Logger`
 		db:     db,`
 	}
 
+	if config.EnableAuth {
+		content += `
+		verifier: verifier,`
+	}
+
+	if hasCache(config) {
+		content += `
+		cache:    store,`
+	}
+
 	content += `
+		limiter:  limiter,
+		registry: registry,
+		checks:   checks,
 	}
 }
 
@@ -108,22 +321,71 @@ func (h *Handler) SetupRoutes() *gin.Engine {
 		gin.SetMode(gin.ReleaseMode)
 	}
 
+	// Собственные логи Gin пишем через Logger
+	gin.DefaultWriter = logger.NewWriter(h.logger, "debug")
+	gin.DefaultErrorWriter = logger.NewWriter(h.logger, "error")
+
 	router := gin.New()
-	
+
+	// X-Forwarded-For учитывается только от прокси из app.trusted_proxies, иначе клиент подменяет свой IP
+	if err := router.SetTrustedProxies(h.cfg.App.TrustedProxies); err != nil {
+		h.logger.Error("Некорректный app.trusted_proxies", "error", err)
+	}
+
 	// Middleware
-	router.Use(gin.Logger())
-	router.Use(gin.Recovery())
+` + tracingMiddlewareUse(config, "router") + stickyPrimaryUse(config, "router") + `	if h.cfg.Middleware.RequestID.Enabled {
+		router.Use(middleware.RequestIDMiddleware(h.logger, h.cfg.Middleware.RequestID))
+	}
+	if h.cfg.Metrics.Enabled {
+		router.Use(middleware.MetricsMiddleware(h.registry, h.cfg.Metrics))
+	}
+	if h.cfg.Middleware.Logger.Enabled {
+		router.Use(middleware.LoggerMiddleware(h.logger, h.cfg.Middleware.Logger))
+	}
+	if h.cfg.Middleware.Recovery.Enabled {
+		router.Use(middleware.RecoveryMiddleware(h.logger))
+	}
+	if h.cfg.Middleware.CORS.Enabled {
+		router.Use(middleware.CORSMiddleware(h.cfg.Changes().CORS))
+	}
 
 	// Health check
 	router.GET("/health", h.HealthCheck)
 
+	// Kubernetes probes: /livez не зависит от внешних сервисов, /readyz и /startupz проверяют зависимости
+	router.GET("/livez", gin.WrapH(h.checks.Handler(health.Liveness)))
+	router.GET("/readyz", gin.WrapH(h.checks.Handler(health.Readiness)))
+	router.GET("/startupz", gin.WrapH(h.checks.Handler(health.Startup)))
+
+	// Prometheus метрики (на основном порту, если metrics.port не задан)
+	if h.cfg.Metrics.Enabled && h.cfg.Metrics.Port == 0 {
+		router.GET(h.cfg.Metrics.Path, gin.WrapH(h.registry.Handler()))
+	}
+
 	// API группа
 	api := router.Group("/api/v1")
+` + optionalAuthUse(config) + `	if h.limiter != nil {
+		api.Use(middleware.RateLimitMiddleware(h.limiter, h.cfg.Changes().RateLimit, "/api/v1", h.logger))
+	}
 	{
 		// Здесь будут API маршруты
 		api.GET("/ping", h.Ping)
 	}
+`
+
+	if config.EnableAuth {
+		content += `
+	// Защищенные маршруты (при auth.enabled)
+	if h.verifier != nil {
+		requireAuth := middleware.AuthMiddleware(h.verifier, h.logger)
+		api.GET("/me", requireAuth, h.Me)
+		// Маршрут с обязательными scopes:
+		// api.DELETE("/users/:id", requireAuth, middleware.RequireScopes("users:write"), h.DeleteUser)
+	}
+`
+	}
 
+	content += `
 	// Swagger
 	if h.cfg.Swagger.Enabled {
 		router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
@@ -152,19 +414,67 @@ func (h *Handler) Ping(c *gin.Context) {
 	return content
 }
 
+// tracingMiddlewareUse возвращает подключение TracingMiddleware, если включен --otel
+func tracingMiddlewareUse(config *ProjectConfig, router string) string {
+	if !config.EnableOTel {
+		return ""
+	}
+	return `	if h.cfg.Tracing.Enabled {
+		` + router + `.Use(middleware.TracingMiddleware())
+	}
+`
+}
+
+// optionalAuthUse возвращает подключение OptionalAuthMiddleware к группе API до rate limiting,
+// чтобы key_by: user получал пользователя из токена, а не IP адрес
+func optionalAuthUse(config *ProjectConfig) string {
+	if !config.EnableAuth {
+		return ""
+	}
+	return `	if h.verifier != nil {
+		api.Use(middleware.OptionalAuthMiddleware(h.verifier, h.logger))
+	}
+`
+}
+
+// stickyPrimaryUse возвращает подключение StickyPrimaryMiddleware, если есть реплики или read preference.
+// Middleware стоит до RequestIDMiddleware, который сохраняет контекст запроса в AppContext
+func stickyPrimaryUse(config *ProjectConfig, router string) string {
+	if !hasReadRouting(config) {
+		return ""
+	}
+	return `	if h.cfg.Database.StickyPrimary {
+		` + router + `.Use(middleware.StickyPrimaryMiddleware())
+	}
+`
+}
+
 // generateFiberHandler генерирует handler для Fiber
 func (g *Generator) generateFiberHandler(config *ProjectConfig) string {
 	content := fmt.Sprintf(`package handlers
 
 import (
 	"github.com/gofiber/fiber/v2"
-	"github.com/gofiber/fiber/v2/middleware/cors"
-	"github.com/gofiber/fiber/v2/middleware/logger"
-	"github.com/gofiber/fiber/v2/middleware/recover"
+	fiberlog "github.com/gofiber/fiber/v2/log"
+	"github.com/gofiber/fiber/v2/middleware/adaptor"
 	"github.com/gofiber/swagger"
 
 	"%s/internal/config"
-	applogger "%s/pkg/logger"`, config.ModuleName, config.ModuleName)
+	"%s/internal/middleware"
+	"%s/pkg/health"
+	applogger "%s/pkg/logger"
+	"%s/pkg/metrics"
+	"%s/pkg/ratelimit"`, config.ModuleName, config.ModuleName, config.ModuleName, config.ModuleName, config.ModuleName, config.ModuleName)
+
+	if config.EnableAuth {
+		content += fmt.Sprintf(`
+	"%s/pkg/auth"`, config.ModuleName)
+	}
+
+	if hasCache(config) {
+		content += fmt.Sprintf(`
+	"%s/pkg/cache"`, config.ModuleName)
+	}
 
 	if !strings.Contains(strings.ToLower(config.Database), "без") {
 		content += fmt.Sprintf(`
@@ -184,7 +494,20 @@ type Handler struct {
 	db     database.Database`
 	}
 
+	if config.EnableAuth {
+		content += `
+	verifier *auth.Verifier`
+	}
+
+	if hasCache(config) {
+		content += `
+	cache    cache.Cache`
+	}
+
 	content += `
+	limiter  ratelimit.Limiter
+	registry *metrics.Registry
+	checks   *health.Registry
 }
 
 // New создает новый handler
@@ -194,7 +517,15 @@ func New(cfg *config.Config, logger applogger.Logger`
 		content += `, db database.Database`
 	}
 
-	content += `) *Handler {
+	if config.EnableAuth {
+		content += `, verifier *auth.Verifier`
+	}
+
+	if hasCache(config) {
+		content += `, store cache.Cache`
+	}
+
+	content += `, limiter ratelimit.Limiter, registry *metrics.Registry, checks *health.Registry) *Handler {
 	return &Handler{
 		cfg:    cfg,
 		logger: logger,`
@@ -204,31 +535,92 @@ func New(cfg *config.Config, logger applogger.Logger`
 		db:     db,`
 	}
 
+	if config.EnableAuth {
+		content += `
+		verifier: verifier,`
+	}
+
+	if hasCache(config) {
+		content += `
+		cache:    store,`
+	}
+
 	content += `
+		limiter:  limiter,
+		registry: registry,
+		checks:   checks,
 	}
 }
 
 // SetupRoutes настраивает маршруты
 func (h *Handler) SetupRoutes() *fiber.App {
+	// Собственные логи Fiber пишем через Logger
+	fiberlog.SetOutput(applogger.NewWriter(h.logger, "info"))
+
 	app := fiber.New(fiber.Config{
-		AppName: h.cfg.App.Name,
+		AppName:               h.cfg.App.Name,
+		DisableStartupMessage: true,
+		// Адрес клиента берется из заголовка только от прокси из app.trusted_proxies. Используется
+		// X-Real-IP: Fiber берет первый адрес X-Forwarded-For, а его задает сам клиент
+		EnableTrustedProxyCheck: true,
+		TrustedProxies:          h.cfg.App.TrustedProxies,
+		ProxyHeader:             "X-Real-IP",
 	})
 
 	// Middleware
-	app.Use(logger.New())
-	app.Use(recover.New())
-	app.Use(cors.New())
+` + tracingMiddlewareUse(config, "app") + stickyPrimaryUse(config, "app") + `	if h.cfg.Middleware.RequestID.Enabled {
+		app.Use(middleware.RequestIDMiddleware(h.logger, h.cfg.Middleware.RequestID))
+	}
+	if h.cfg.Metrics.Enabled {
+		app.Use(middleware.MetricsMiddleware(h.registry, h.cfg.Metrics))
+	}
+	if h.cfg.Middleware.Logger.Enabled {
+		app.Use(middleware.LoggerMiddleware(h.logger, h.cfg.Middleware.Logger))
+	}
+	if h.cfg.Middleware.Recovery.Enabled {
+		app.Use(middleware.RecoveryMiddleware(h.logger))
+	}
+	if h.cfg.Middleware.CORS.Enabled {
+		app.Use(middleware.CORSMiddleware(h.cfg.Changes().CORS))
+	}
 
 	// Health check
 	app.Get("/health", h.HealthCheck)
 
+	// Kubernetes probes: /livez не зависит от внешних сервисов, /readyz и /startupz проверяют зависимости
+	app.Get("/livez", adaptor.HTTPHandler(h.checks.Handler(health.Liveness)))
+	app.Get("/readyz", adaptor.HTTPHandler(h.checks.Handler(health.Readiness)))
+	app.Get("/startupz", adaptor.HTTPHandler(h.checks.Handler(health.Startup)))
+
+	// Prometheus метрики (на основном порту, если metrics.port не задан)
+	if h.cfg.Metrics.Enabled && h.cfg.Metrics.Port == 0 {
+		app.Get(h.cfg.Metrics.Path, adaptor.HTTPHandler(h.registry.Handler()))
+	}
+
 	// API группа
 	api := app.Group("/api/v1")
+` + optionalAuthUse(config) + `	if h.limiter != nil {
+		api.Use(middleware.RateLimitMiddleware(h.limiter, h.cfg.Changes().RateLimit, "/api/v1", h.logger))
+	}
 	{
 		// Здесь будут API маршруты
 		api.Get("/ping", h.Ping)
 	}
+`
+
+	if config.EnableAuth {
+		content += `
+	// Защищенные маршруты (при auth.enabled)
+	if h.verifier != nil {
+		requireAuth := middleware.AuthMiddleware(h.verifier, h.logger)
+		api.Get("/me", requireAuth, h.Me)
+		// Маршрут с обязательными scopes:
+		// api.Delete("/users/:id", requireAuth, middleware.RequireScopes("users:write"), h.DeleteUser)
+	}
+`
+	}
 
+	content += `
 	// Swagger
 	if h.cfg.Swagger.Enabled {
 		app.Get("/swagger/*", swagger.HandlerDefault)
@@ -265,11 +657,24 @@ import (
 	"net/http"
 
 	"github.com/labstack/echo/v4"
-	"github.com/labstack/echo/v4/middleware"
 	echoSwagger "github.com/swaggo/echo-swagger"
 
 	"%s/internal/config"
-	"%s/pkg/logger"`, config.ModuleName, config.ModuleName)
+	"%s/internal/middleware"
+	"%s/pkg/health"
+	"%s/pkg/logger"
+	"%s/pkg/metrics"
+	"%s/pkg/ratelimit"`, config.ModuleName, config.ModuleName, config.ModuleName, config.ModuleName, config.ModuleName, config.ModuleName)
+
+	if config.EnableAuth {
+		content += fmt.Sprintf(`
+	"%s/pkg/auth"`, config.ModuleName)
+	}
+
+	if hasCache(config) {
+		content += fmt.Sprintf(`
+	"%s/pkg/cache"`, config.ModuleName)
+	}
 
 	if !strings.Contains(strings.ToLower(config.Database), "без") {
 		content += fmt.Sprintf(`
@@ -289,7 +694,20 @@ type Handler struct {
 	db     database.Database`
 	}
 
+	if config.EnableAuth {
+		content += `
+	verifier *auth.Verifier`
+	}
+
+	if hasCache(config) {
+		content += `
+	cache    cache.Cache`
+	}
+
 	content += `
+	limiter  ratelimit.Limiter
+	registry *metrics.Registry
+	checks   *health.Registry
 }
 
 // New создает новый handler
@@ -299,7 +717,15 @@ func New(cfg *config.Config, logger logger.Logger`
 		content += `, db database.Database`
 	}
 
-	content += `) *Handler {
+	if config.EnableAuth {
+		content += `, verifier *auth.Verifier`
+	}
+
+	if hasCache(config) {
+		content += `, store cache.Cache`
+	}
+
+	content += `, limiter ratelimit.Limiter, registry *metrics.Registry, checks *health.Registry) *Handler {
 	return &Handler{
 		cfg:    cfg,
 		logger: logger,`
@@ -309,29 +735,98 @@ func New(cfg *config.Config, logger logger.Logger`
 		db:     db,`
 	}
 
+	if config.EnableAuth {
+		content += `
+		verifier: verifier,`
+	}
+
+	if hasCache(config) {
+		content += `
+		cache:    store,`
+	}
+
 	content += `
+		limiter:  limiter,
+		registry: registry,
+		checks:   checks,
 	}
 }
 
 // SetupRoutes настраивает маршруты
 func (h *Handler) SetupRoutes() *echo.Echo {
 	e := echo.New()
+	e.HideBanner = true
+	e.HidePort = true
+
+	// X-Forwarded-For учитывается только от прокси из app.trusted_proxies, иначе клиент подменяет свой IP.
+	// Адреса проверяет validate при загрузке конфигурации
+	e.IPExtractor = echo.ExtractIPDirect()
+	if proxies, _ := h.cfg.App.TrustedProxyNets(); len(proxies) > 0 {
+		trust := []echo.TrustOption{echo.TrustLoopback(false), echo.TrustLinkLocal(false), echo.TrustPrivateNet(false)}
+		for _, proxy := range proxies {
+			trust = append(trust, echo.TrustIPRange(proxy))
+		}
+		e.IPExtractor = echo.ExtractIPFromXFFHeader(trust...)
+	}
+
+	// Собственные логи Echo пишем через Logger
+	e.Logger.SetHeader("${level}")
+	e.Logger.SetOutput(logger.NewWriter(h.logger, "info"))
 
 	// Middleware
-	e.Use(middleware.Logger())
-	e.Use(middleware.Recover())
-	e.Use(middleware.CORS())
+` + tracingMiddlewareUse(config, "e") + stickyPrimaryUse(config, "e") + `	if h.cfg.Middleware.RequestID.Enabled {
+		e.Use(middleware.RequestIDMiddleware(h.logger, h.cfg.Middleware.RequestID))
+	}
+	if h.cfg.Metrics.Enabled {
+		e.Use(middleware.MetricsMiddleware(h.registry, h.cfg.Metrics))
+	}
+	if h.cfg.Middleware.Logger.Enabled {
+		e.Use(middleware.LoggerMiddleware(h.logger, h.cfg.Middleware.Logger))
+	}
+	if h.cfg.Middleware.Recovery.Enabled {
+		e.Use(middleware.RecoveryMiddleware(h.logger))
+	}
+	if h.cfg.Middleware.CORS.Enabled {
+		e.Use(middleware.CORSMiddleware(h.cfg.Changes().CORS))
+	}
 
 	// Health check
 	e.GET("/health", h.HealthCheck)
 
+	// Kubernetes probes: /livez не зависит от внешних сервисов, /readyz и /startupz проверяют зависимости
+	e.GET("/livez", echo.WrapHandler(h.checks.Handler(health.Liveness)))
+	e.GET("/readyz", echo.WrapHandler(h.checks.Handler(health.Readiness)))
+	e.GET("/startupz", echo.WrapHandler(h.checks.Handler(health.Startup)))
+
+	// Prometheus метрики (на основном порту, если metrics.port не задан)
+	if h.cfg.Metrics.Enabled && h.cfg.Metrics.Port == 0 {
+		e.GET(h.cfg.Metrics.Path, echo.WrapHandler(h.registry.Handler()))
+	}
+
 	// API группа
 	api := e.Group("/api/v1")
+` + optionalAuthUse(config) + `	if h.limiter != nil {
+		api.Use(middleware.RateLimitMiddleware(h.limiter, h.cfg.Changes().RateLimit, "/api/v1", h.logger))
+	}
 	{
 		// Здесь будут API маршруты
 		api.GET("/ping", h.Ping)
 	}
+`
 
+	if config.EnableAuth {
+		content += `
+	// Защищенные маршруты (при auth.enabled)
+	if h.verifier != nil {
+		requireAuth := middleware.AuthMiddleware(h.verifier, h.logger)
+		api.GET("/me", h.Me, requireAuth)
+		// Маршрут с обязательными scopes:
+		// api.DELETE("/users/:id", h.DeleteUser, requireAuth, middleware.RequireScopes("users:write"))
+	}
+`
+	}
+
+	content += `
 	// Swagger
 	if h.cfg.Swagger.Enabled {
 		e.GET("/swagger/*", echoSwagger.WrapHandler)
@@ -638,42 +1133,3 @@ func (h *Handler) HealthCheck(c echo.Context) error {
 
 	return content
 }
-
-// generateMiddleware создает middleware файлы
-func (g *Generator) generateMiddleware(config *ProjectConfig) error {
-	content := fmt.Sprintf(`package middleware
-
-import (
-	"time"
-
-	"%s/pkg/logger"
-)
-
-// LoggerMiddleware middleware для логирования
-func LoggerMiddleware(logger logger.Logger) interface{} {
-	// TODO: Реализовать middleware для выбранного фреймворка
-	return nil
-}
-
-// AuthMiddleware middleware для аутентификации
-func AuthMiddleware(logger logger.Logger) interface{} {
-	// TODO: Реализовать аутентификацию
-	return nil
-}
-
-// CORSMiddleware middleware для CORS
-func CORSMiddleware() interface{} {
-	// TODO: Реализовать CORS
-	return nil
-}
-
-// RateLimitMiddleware middleware для ограничения запросов
-func RateLimitMiddleware(requests int, window time.Duration) interface{} {
-	// TODO: Реализовать rate limiting
-	return nil
-}
-`, config.ModuleName)
-
-	middlewarePath := filepath.Join(g.projectPath, "internal/middleware/middleware.go")
-	return os.WriteFile(middlewarePath, []byte(content), 0644)
-}
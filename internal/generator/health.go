@@ -0,0 +1,554 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// generateHealth создает пакет pkg/health с реестром проверок для liveness, readiness и startup probes
+func (g *Generator) generateHealth(config *ProjectConfig) error {
+	healthDir := filepath.Join(g.projectPath, "pkg/health")
+	if err := os.MkdirAll(healthDir, 0755); err != nil {
+		return err
+	}
+
+	files := map[string]string{
+		"health.go":      g.generateHealthRegistry(config),
+		"checkers.go":    g.generateHealthCheckers(config),
+		"disk_unix.go":   g.generateHealthDiskUnix(),
+		"disk_other.go":  g.generateHealthDiskOther(),
+		"health_test.go": g.generateHealthTest(),
+	}
+
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(healthDir, name), []byte(content), 0644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// generateHealthRegistry генерирует реестр именованных проверок с таймаутами и кэшированием
+func (g *Generator) generateHealthRegistry(config *ProjectConfig) string {
+	return `package health
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"` + config.ModuleName + `/internal/config"
+)
+
+// Probe вид проверки Kubernetes
+type Probe string
+
+const (
+	// Liveness процесс жив, ошибка приводит к перезапуску pod
+	Liveness Probe = "liveness"
+	// Readiness сервис готов принимать трафик, ошибка только исключает pod из балансировки
+	Readiness Probe = "readiness"
+	// Startup сервис завершил запуск
+	Startup Probe = "startup"
+)
+
+const (
+	// StatusOK проверка прошла
+	StatusOK = "ok"
+	// StatusFail проверка не прошла
+	StatusFail = "fail"
+
+	defaultTimeout = 2 * time.Second
+)
+
+// Checker именованная проверка зависимости
+type Checker interface {
+	Name() string
+	Check(ctx context.Context) error
+}
+
+// NewChecker создает Checker из функции
+func NewChecker(name string, check func(ctx context.Context) error) Checker {
+	return &funcChecker{name: name, check: check}
+}
+
+type funcChecker struct {
+	name  string
+	check func(ctx context.Context) error
+}
+
+func (c *funcChecker) Name() string                    { return c.name }
+func (c *funcChecker) Check(ctx context.Context) error { return c.check(ctx) }
+
+// CheckResult результат одной проверки
+type CheckResult struct {
+	Name     string ` + "`" + `json:"name"` + "`" + `
+	Status   string ` + "`" + `json:"status"` + "`" + `
+	Error    string ` + "`" + `json:"error,omitempty"` + "`" + `
+	Duration string ` + "`" + `json:"duration"` + "`" + `
+	Cached   bool   ` + "`" + `json:"cached,omitempty"` + "`" + `
+}
+
+// Report итог probe со списком проверок
+type Report struct {
+	Probe  Probe         ` + "`" + `json:"probe"` + "`" + `
+	Status string        ` + "`" + `json:"status"` + "`" + `
+	Checks []CheckResult ` + "`" + `json:"checks"` + "`" + `
+}
+
+// Option настраивает регистрируемую проверку
+type Option func(*entry)
+
+// WithTimeout задает таймаут проверки
+func WithTimeout(timeout time.Duration) Option {
+	return func(e *entry) { e.timeout = timeout }
+}
+
+// WithCacheTTL задает время, в течение которого переиспользуется последний результат
+func WithCacheTTL(ttl time.Duration) Option {
+	return func(e *entry) { e.cacheTTL = ttl }
+}
+
+// ForProbes задает probes, в которых участвует проверка (по умолчанию readiness и startup)
+func ForProbes(probes ...Probe) Option {
+	return func(e *entry) { e.probes = probes }
+}
+
+// entry зарегистрированная проверка и ее последний результат
+type entry struct {
+	checker  Checker
+	probes   []Probe
+	timeout  time.Duration
+	cacheTTL time.Duration
+
+	mu        sync.Mutex
+	last      CheckResult
+	checkedAt time.Time
+}
+
+// Registry хранит проверки и выполняет их для probes
+type Registry struct {
+	timeout  time.Duration
+	cacheTTL time.Duration
+
+	mu      sync.RWMutex
+	entries []*entry
+	started atomic.Bool
+	stopped atomic.Bool
+}
+
+// New создает реестр и регистрирует проверки из конфигурации (диск, внешние HTTP сервисы)
+func New(cfg config.HealthConfig) *Registry {
+	r := &Registry{
+		timeout:  time.Duration(cfg.Timeout) * time.Second,
+		cacheTTL: time.Duration(cfg.CacheTTL) * time.Second,
+	}
+	if r.timeout <= 0 {
+		r.timeout = defaultTimeout
+	}
+
+	if cfg.Disk.Enabled {
+		r.Register(DiskSpace(cfg.Disk.Path, uint64(cfg.Disk.MinFreeMB)<<20))
+	}
+	for _, dep := range cfg.HTTP {
+		r.Register(HTTP(dep.Name, dep.URL))
+	}
+
+	return r
+}
+
+// Register добавляет проверку
+func (r *Registry) Register(checker Checker, opts ...Option) {
+	e := &entry{
+		checker:  checker,
+		probes:   []Probe{Readiness, Startup},
+		timeout:  r.timeout,
+		cacheTTL: r.cacheTTL,
+	}
+	for _, opt := range opts {
+		opt(e)
+	}
+
+	r.mu.Lock()
+	r.entries = append(r.entries, e)
+	r.mu.Unlock()
+}
+
+// MarkStarted отмечает завершение запуска, до этого startup и readiness не проходят
+func (r *Registry) MarkStarted() {
+	r.started.Store(true)
+}
+
+// MarkStopped отмечает, что сервер перестал принимать запросы, после этого readiness не проходит
+func (r *Registry) MarkStopped() {
+	r.stopped.Store(true)
+}
+
+// Run параллельно выполняет проверки probe
+func (r *Registry) Run(ctx context.Context, probe Probe) Report {
+	r.mu.RLock()
+	var entries []*entry
+	for _, e := range r.entries {
+		if e.has(probe) {
+			entries = append(entries, e)
+		}
+	}
+	r.mu.RUnlock()
+
+	report := Report{Probe: probe, Status: StatusOK, Checks: make([]CheckResult, len(entries))}
+
+	var wg sync.WaitGroup
+	for i, e := range entries {
+		wg.Add(1)
+		go func(i int, e *entry) {
+			defer wg.Done()
+			report.Checks[i] = e.run(ctx)
+		}(i, e)
+	}
+	wg.Wait()
+
+	if probe != Liveness && !r.started.Load() {
+		report.Checks = append(report.Checks, CheckResult{Name: "startup", Status: StatusFail, Error: "запуск не завершен"})
+	}
+	if probe == Readiness && r.stopped.Load() {
+		report.Checks = append(report.Checks, CheckResult{Name: "server", Status: StatusFail, Error: "сервер остановлен"})
+	}
+
+	for _, check := range report.Checks {
+		if check.Status != StatusOK {
+			report.Status = StatusFail
+			break
+		}
+	}
+
+	return report
+}
+
+// Handler возвращает HTTP handler probe: 200 или 503, с ?verbose - подробный JSON отчет
+func (r *Registry) Handler(probe Probe) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		report := r.Run(req.Context(), probe)
+
+		status := http.StatusOK
+		if report.Status != StatusOK {
+			status = http.StatusServiceUnavailable
+		}
+
+		if _, verbose := req.URL.Query()["verbose"]; verbose {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(status)
+			_ = json.NewEncoder(w).Encode(report)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.WriteHeader(status)
+		fmt.Fprintln(w, report.Status)
+	})
+}
+
+// has проверяет, участвует ли проверка в probe
+func (e *entry) has(probe Probe) bool {
+	for _, p := range e.probes {
+		if p == probe {
+			return true
+		}
+	}
+	return false
+}
+
+// run выполняет проверку или возвращает кэшированный результат.
+// Одновременные probes ждут одну проверку, а не запускают ее повторно
+func (e *entry) run(ctx context.Context) CheckResult {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.cacheTTL > 0 && !e.checkedAt.IsZero() && time.Since(e.checkedAt) < e.cacheTTL {
+		result := e.last
+		result.Cached = true
+		return result
+	}
+
+	start := time.Now()
+	err := check(ctx, e.checker, e.timeout)
+
+	result := CheckResult{
+		Name:     e.checker.Name(),
+		Status:   StatusOK,
+		Duration: time.Since(start).String(),
+	}
+	if err != nil {
+		result.Status = StatusFail
+		result.Error = err.Error()
+	}
+
+	e.last = result
+	e.checkedAt = time.Now()
+	return result
+}
+
+// check выполняет проверку с таймаутом. Отмена запроса probe не прерывает проверку,
+// чтобы в кэш не попадал результат оборванного запроса
+func check(ctx context.Context, checker Checker, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), timeout)
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- checker.Check(ctx)
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return fmt.Errorf("превышен таймаут проверки %s", timeout)
+	}
+}
+`
+}
+
+// generateHealthCheckers генерирует встроенные проверки: БД, Redis, HTTP и свободное место на диске
+func (g *Generator) generateHealthCheckers(config *ProjectConfig) string {
+	hasDB := !strings.Contains(strings.ToLower(config.Database), "без")
+
+	content := `package health
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/redis/go-redis/v9"`
+
+	if hasDB {
+		content += `
+
+	"` + config.ModuleName + `/pkg/database"`
+	}
+
+	content += `
+)
+`
+
+	if hasDB {
+		content += `
+// Database проверяет соединение с БД
+func Database(db database.Database) Checker {
+	return NewChecker("database", func(context.Context) error {
+		return db.Ping()
+	})
+}
+`
+	}
+
+	if supportsReplicas(config) {
+		content += `
+// Replicas проверяет реплики для чтения, каждую отдельной проверкой "database:<имя>"
+func Replicas(db database.Database) []Checker {
+	replicas, ok := db.(database.ReplicaSet)
+	if !ok {
+		return nil
+	}
+
+	var checkers []Checker
+	for _, name := range replicas.ReplicaNames() {
+		name := name
+		checkers = append(checkers, NewChecker("database:"+name, func(ctx context.Context) error {
+			return replicas.PingReplica(ctx, name)
+		}))
+	}
+	return checkers
+}
+`
+	}
+
+	content += `
+// Redis проверяет соединение с Redis
+func Redis(client redis.UniversalClient) Checker {
+	return NewChecker("redis", func(ctx context.Context) error {
+		return client.Ping(ctx).Err()
+	})
+}
+
+// HTTP проверяет, что внешний сервис отвечает статусом меньше 400
+func HTTP(name, url string) Checker {
+	client := &http.Client{}
+
+	return NewChecker(name, func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+		if resp.StatusCode >= http.StatusBadRequest {
+			return fmt.Errorf("%s вернул статус %d", url, resp.StatusCode)
+		}
+		return nil
+	})
+}
+
+// DiskSpace проверяет, что на разделе с path свободно не меньше minFree байт
+func DiskSpace(path string, minFree uint64) Checker {
+	if path == "" {
+		path = "/"
+	}
+
+	return NewChecker("disk", func(context.Context) error {
+		free, err := diskFree(path)
+		if err != nil {
+			return err
+		}
+		if free < minFree {
+			return fmt.Errorf("свободно %d MB на %s, минимум %d MB", free>>20, path, minFree>>20)
+		}
+		return nil
+	})
+}
+`
+
+	return content
+}
+
+// generateHealthDiskUnix генерирует получение свободного места через statfs
+func (g *Generator) generateHealthDiskUnix() string {
+	return `//go:build linux || darwin || freebsd
+
+package health
+
+import "syscall"
+
+// diskFree возвращает количество байт, доступных непривилегированному пользователю
+func diskFree(path string) (uint64, error) {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(path, &stat); err != nil {
+		return 0, err
+	}
+	return uint64(stat.Bavail) * uint64(stat.Bsize), nil
+}
+`
+}
+
+// generateHealthDiskOther генерирует заглушку для платформ без statfs
+func (g *Generator) generateHealthDiskOther() string {
+	return `//go:build !linux && !darwin && !freebsd
+
+package health
+
+import "errors"
+
+// diskFree не поддерживается на этой платформе
+func diskFree(string) (uint64, error) {
+	return 0, errors.New("проверка свободного места не поддерживается на этой платформе")
+}
+`
+}
+
+// generateHealthTest генерирует тесты реестра проверок
+func (g *Generator) generateHealthTest() string {
+	return `package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newTestRegistry() *Registry {
+	return &Registry{timeout: time.Second}
+}
+
+func TestReadinessFailsUntilStarted(t *testing.T) {
+	registry := newTestRegistry()
+
+	if report := registry.Run(context.Background(), Readiness); report.Status != StatusFail {
+		t.Fatalf("readiness should fail before MarkStarted, got %s", report.Status)
+	}
+	if report := registry.Run(context.Background(), Liveness); report.Status != StatusOK {
+		t.Fatalf("liveness should not depend on startup, got %s", report.Status)
+	}
+
+	registry.MarkStarted()
+	if report := registry.Run(context.Background(), Readiness); report.Status != StatusOK {
+		t.Fatalf("readiness should pass after MarkStarted, got %s", report.Status)
+	}
+
+	registry.MarkStopped()
+	if report := registry.Run(context.Background(), Readiness); report.Status != StatusFail {
+		t.Fatalf("readiness should fail after MarkStopped, got %s", report.Status)
+	}
+	if report := registry.Run(context.Background(), Liveness); report.Status != StatusOK {
+		t.Fatalf("liveness should not depend on the server state, got %s", report.Status)
+	}
+}
+
+func TestFailingDependencyDoesNotAffectLiveness(t *testing.T) {
+	registry := newTestRegistry()
+	registry.MarkStarted()
+	registry.Register(NewChecker("database", func(context.Context) error {
+		return errors.New("connection refused")
+	}))
+
+	rec := httptest.NewRecorder()
+	registry.Handler(Readiness).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz?verbose", nil))
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("expected 503, got %d", rec.Code)
+	}
+
+	var report Report
+	if err := json.NewDecoder(rec.Body).Decode(&report); err != nil {
+		t.Fatalf("invalid verbose report: %v", err)
+	}
+	if len(report.Checks) != 1 || report.Checks[0].Error != "connection refused" {
+		t.Errorf("unexpected report: %+v", report)
+	}
+
+	rec = httptest.NewRecorder()
+	registry.Handler(Liveness).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/livez", nil))
+	if rec.Code != http.StatusOK || rec.Body.String() != "ok\n" {
+		t.Errorf("liveness should pass, got %d %q", rec.Code, rec.Body.String())
+	}
+}
+
+func TestCheckTimeoutAndCache(t *testing.T) {
+	registry := newTestRegistry()
+	registry.MarkStarted()
+
+	var calls atomic.Int32
+	registry.Register(NewChecker("slow", func(ctx context.Context) error {
+		calls.Add(1)
+		<-ctx.Done()
+		return ctx.Err()
+	}), WithTimeout(20*time.Millisecond), WithCacheTTL(time.Minute))
+
+	first := registry.Run(context.Background(), Readiness)
+	if first.Status != StatusFail || first.Checks[0].Cached {
+		t.Fatalf("expected fresh timeout failure, got %+v", first)
+	}
+
+	second := registry.Run(context.Background(), Readiness)
+	if !second.Checks[0].Cached || calls.Load() != 1 {
+		t.Errorf("expected cached result and a single call, got %+v, calls=%d", second, calls.Load())
+	}
+}
+`
+}
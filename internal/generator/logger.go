@@ -0,0 +1,1105 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// loggerBackend возвращает выбранную реализацию логгера (по умолчанию logrus)
+func loggerBackend(config *ProjectConfig) string {
+	switch strings.ToLower(config.Logger) {
+	case "slog", "zap", "zerolog":
+		return strings.ToLower(config.Logger)
+	default:
+		return "logrus"
+	}
+}
+
+// loggerDependency возвращает зависимость go.mod для выбранного логгера
+func loggerDependency(config *ProjectConfig) string {
+	switch loggerBackend(config) {
+	case "slog":
+		return ""
+	case "zap":
+		return "go.uber.org/zap v1.26.0"
+	case "zerolog":
+		return "github.com/rs/zerolog v1.31.0"
+	default:
+		return "github.com/sirupsen/logrus v1.9.3"
+	}
+}
+
+// generateLogger создает пакет pkg/logger: общий интерфейс и реализацию выбранного backend
+func (g *Generator) generateLogger(config *ProjectConfig) error {
+	loggerDir := filepath.Join(g.projectPath, "pkg/logger")
+
+	var backend string
+	switch loggerBackend(config) {
+	case "slog":
+		backend = g.generateSlogLogger()
+	case "zap":
+		backend = g.generateZapLogger()
+	case "zerolog":
+		backend = g.generateZerologLogger()
+	default:
+		backend = g.generateLogrusLogger()
+	}
+
+	files := map[string]string{
+		"logger.go":                   g.generateLoggerInterface(config),
+		"levels.go":                   g.generateLoggerLevels(),
+		loggerBackend(config) + ".go": backend,
+		"logger_test.go":              g.generateLoggerTest(config),
+	}
+
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(loggerDir, name), []byte(content), 0644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// loggerTraceImport возвращает импорт OpenTelemetry для логгера
+func loggerTraceImport(config *ProjectConfig) string {
+	if !config.EnableOTel {
+		return ""
+	}
+	return `
+	"go.opentelemetry.io/otel/trace"`
+}
+
+// loggerTraceFields добавляет trace_id и span_id в поля корреляции, если включен --otel
+func loggerTraceFields(config *ProjectConfig) string {
+	if !config.EnableOTel {
+		return ""
+	}
+	return `
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		fields = append(fields, "trace_id", sc.TraceID().String(), "span_id", sc.SpanID().String())
+	}`
+}
+
+// generateLoggerInterface генерирует интерфейс Logger, выходы и общие для всех backend функции
+func (g *Generator) generateLoggerInterface(config *ProjectConfig) string {
+	return `package logger
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"strings"` + loggerTraceImport(config) + `
+
+	"gopkg.in/natefinch/lumberjack.v2"
+
+	"` + config.ModuleName + `/pkg/requestid"
+)
+
+// Logger интерфейс для логгирования
+type Logger interface {
+	Debug(msg string, fields ...interface{})
+	Info(msg string, fields ...interface{})
+	Warn(msg string, fields ...interface{})
+	Error(msg string, fields ...interface{})
+	Fatal(msg string, fields ...interface{})
+	WithField(key string, value interface{}) Logger
+	WithFields(fields map[string]interface{}) Logger
+	WithContext(ctx context.Context) Logger
+	// Named возвращает логгер пакета, для него действует уровень из packages
+	Named(name string) Logger
+}
+
+// LoggerConfig конфигурация логгера
+type LoggerConfig struct {
+	Level    string
+	Format   string
+	Output   string
+	File     FileConfig
+	Sinks    []SinkConfig
+	Packages map[string]string
+}
+
+// FileConfig файловый вывод с ротацией по размеру и возрасту
+type FileConfig struct {
+	Path       string
+	MaxSizeMB  int
+	MaxAgeDays int
+	MaxBackups int
+	Compress   bool
+}
+
+// SinkConfig отдельный выход логов. Level - минимальный уровень выхода, пустой - без ограничения
+type SinkConfig struct {
+	Output string
+	Format string
+	Level  string
+	File   FileConfig
+}
+
+// sinkOptions параметры создания backend для одного выхода
+type sinkOptions struct {
+	Format     string
+	Level      string
+	Out        io.Writer
+	Console    bool
+	CallerSkip int
+}
+
+// Root корневой логгер: управляет уровнями и закрывает файловые выходы
+type Root struct {
+	Logger
+	levels  *Levels
+	closers []io.Closer
+}
+
+// New создает новый логгер. Без sinks используется один выход output/format
+func New(config LoggerConfig) *Root {
+	sinks := config.Sinks
+	if len(sinks) == 0 {
+		sinks = []SinkConfig{{Output: config.Output, Format: config.Format, File: config.File}}
+	}
+
+	root := &Root{levels: NewLevels(config.Level, config.Packages)}
+
+	// Уровень проверяет обертка с Levels, backend получает этот кадр и, при нескольких выходах, multiLogger
+	callerSkip := 2
+	if len(sinks) > 1 {
+		callerSkip++
+	}
+
+	backends := make(multiLogger, 0, len(sinks))
+	for _, sink := range sinks {
+		out, console := root.output(sink, config.File)
+		level := sink.Level
+		if level == "" {
+			level = "debug"
+		}
+		backends = append(backends, newLogger(sinkOptions{
+			Format:     sink.Format,
+			Level:      level,
+			Out:        out,
+			Console:    console,
+			CallerSkip: callerSkip,
+		}))
+	}
+
+	var next Logger = backends
+	if len(backends) == 1 {
+		next = backends[0]
+	}
+
+	root.Logger = &leveledLogger{next: next, levels: root.levels}
+	return root
+}
+
+// Levels возвращает уровни логирования для изменения во время работы
+func (r *Root) Levels() *Levels {
+	return r.levels
+}
+
+// Close закрывает файловые выходы
+func (r *Root) Close() error {
+	var errs []error
+	for _, c := range r.closers {
+		errs = append(errs, c.Close())
+	}
+	return errors.Join(errs...)
+}
+
+// output возвращает writer выхода и признак вывода в консоль
+func (r *Root) output(sink SinkConfig, defaults FileConfig) (io.Writer, bool) {
+	switch sink.Output {
+	case "stderr":
+		return os.Stderr, true
+	case "file":
+		file := sink.File
+		if file.Path == "" {
+			file = defaults
+		}
+		if file.Path == "" {
+			file.Path = "logs/app.log"
+		}
+
+		w := &lumberjack.Logger{
+			Filename:   file.Path,
+			MaxSize:    file.MaxSizeMB,
+			MaxAge:     file.MaxAgeDays,
+			MaxBackups: file.MaxBackups,
+			Compress:   file.Compress,
+			LocalTime:  true,
+		}
+		r.closers = append(r.closers, w)
+		return w, false
+	default:
+		return os.Stdout, true
+	}
+}
+
+// contextFields возвращает поля корреляции из контекста в виде пар ключ-значение
+func contextFields(ctx context.Context) []interface{} {
+	var fields []interface{}
+	if id := requestid.FromContext(ctx); id != "" {
+		fields = append(fields, "request_id", id)
+	}` + loggerTraceFields(config) + `
+	return fields
+}
+
+// mapFields переводит map полей в пары ключ-значение
+func mapFields(fields map[string]interface{}) []interface{} {
+	pairs := make([]interface{}, 0, len(fields)*2)
+	for key, value := range fields {
+		pairs = append(pairs, key, value)
+	}
+	return pairs
+}
+
+// NewWriter возвращает io.Writer, который пишет каждую строку в log с уровнем level.
+// Используется, чтобы собственные логи фреймворка и net/http шли через Logger
+func NewWriter(log Logger, level string) io.Writer {
+	return &writer{log: log, level: level}
+}
+
+type writer struct {
+	log   Logger
+	level string
+}
+
+// Write логирует каждую непустую строку
+func (w *writer) Write(p []byte) (int, error) {
+	for _, line := range strings.Split(string(p), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		switch w.level {
+		case "debug":
+			w.log.Debug(line)
+		case "warn":
+			w.log.Warn(line)
+		case "error":
+			w.log.Error(line)
+		default:
+			w.log.Info(line)
+		}
+	}
+	return len(p), nil
+}
+`
+}
+
+// generateLoggerLevels генерирует уровни с переопределением для пакетов и изменением во время работы
+func (g *Generator) generateLoggerLevels() string {
+	return `package logger
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// Level уровень логирования
+type Level int32
+
+const (
+	DebugLevel Level = iota
+	InfoLevel
+	WarnLevel
+	ErrorLevel
+	FatalLevel
+)
+
+// ParseLevel разбирает уровень из конфигурации
+func ParseLevel(level string) (Level, error) {
+	switch strings.ToLower(level) {
+	case "debug", "trace":
+		return DebugLevel, nil
+	case "info", "":
+		return InfoLevel, nil
+	case "warn", "warning":
+		return WarnLevel, nil
+	case "error":
+		return ErrorLevel, nil
+	case "fatal", "panic":
+		return FatalLevel, nil
+	default:
+		return InfoLevel, fmt.Errorf("неизвестный уровень логирования: %s", level)
+	}
+}
+
+// String возвращает название уровня
+func (l Level) String() string {
+	switch l {
+	case DebugLevel:
+		return "debug"
+	case InfoLevel:
+		return "info"
+	case WarnLevel:
+		return "warn"
+	case ErrorLevel:
+		return "error"
+	default:
+		return "fatal"
+	}
+}
+
+// Levels общий уровень и уровни пакетов, безопасны для изменения во время работы
+type Levels struct {
+	level    atomic.Int32
+	mu       sync.Mutex
+	packages atomic.Pointer[map[string]Level]
+}
+
+// NewLevels создает уровни из конфигурации, некорректные значения заменяются на info
+func NewLevels(level string, packages map[string]string) *Levels {
+	l := &Levels{}
+	l.Reset(level, packages)
+	return l
+}
+
+// Reset заменяет общий уровень и уровни всех пакетов, например после перезагрузки конфигурации
+func (l *Levels) Reset(level string, packages map[string]string) {
+	overrides := make(map[string]Level, len(packages))
+	for name, value := range packages {
+		overrides[name], _ = ParseLevel(value)
+	}
+	parsed, _ := ParseLevel(level)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.level.Store(int32(parsed))
+	l.packages.Store(&overrides)
+}
+
+// Level возвращает общий уровень
+func (l *Levels) Level() Level {
+	return Level(l.level.Load())
+}
+
+// SetLevel меняет общий уровень
+func (l *Levels) SetLevel(level Level) {
+	l.level.Store(int32(level))
+}
+
+// SetPackageLevel задает уровень пакета
+func (l *Levels) SetPackageLevel(name string, level Level) {
+	l.update(func(packages map[string]Level) { packages[name] = level })
+}
+
+// ResetPackageLevel убирает уровень пакета, для него снова действует общий
+func (l *Levels) ResetPackageLevel(name string) {
+	l.update(func(packages map[string]Level) { delete(packages, name) })
+}
+
+// Packages возвращает копию уровней пакетов
+func (l *Levels) Packages() map[string]Level {
+	current := *l.packages.Load()
+	result := make(map[string]Level, len(current))
+	for name, level := range current {
+		result[name] = level
+	}
+	return result
+}
+
+// Enabled проверяет, пишется ли сообщение уровня level для пакета name.
+// Для вложенных имен (repository.user) ищется ближайший настроенный родитель
+func (l *Levels) Enabled(name string, level Level) bool {
+	packages := *l.packages.Load()
+	for name != "" {
+		if min, ok := packages[name]; ok {
+			return level >= min
+		}
+		i := strings.LastIndex(name, ".")
+		if i < 0 {
+			break
+		}
+		name = name[:i]
+	}
+	return level >= l.Level()
+}
+
+// update копирует map уровней пакетов, меняет и атомарно подменяет ее
+func (l *Levels) update(change func(map[string]Level)) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	packages := l.Packages()
+	change(packages)
+	l.packages.Store(&packages)
+}
+
+// levelsState представление уровней в HTTP API
+type levelsState struct {
+	Level    string            ` + "`" + `json:"level"` + "`" + `
+	Packages map[string]string ` + "`" + `json:"packages"` + "`" + `
+}
+
+// levelChange запрос изменения уровня. С package меняется уровень пакета, пустой level сбрасывает его
+type levelChange struct {
+	Level   string ` + "`" + `json:"level"` + "`" + `
+	Package string ` + "`" + `json:"package"` + "`" + `
+}
+
+// Handler возвращает HTTP handler: GET - текущие уровни, PUT - изменение
+func (l *Levels) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPut, http.MethodPost:
+			var change levelChange
+			if err := json.NewDecoder(r.Body).Decode(&change); err != nil {
+				http.Error(w, "некорректный JSON: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			if change.Package != "" && change.Level == "" {
+				l.ResetPackageLevel(change.Package)
+				break
+			}
+
+			level, err := ParseLevel(change.Level)
+			if err != nil || change.Level == "" {
+				http.Error(w, fmt.Sprintf("некорректный уровень: %q", change.Level), http.StatusBadRequest)
+				return
+			}
+			if change.Package != "" {
+				l.SetPackageLevel(change.Package, level)
+			} else {
+				l.SetLevel(level)
+			}
+		default:
+			w.Header().Set("Allow", "GET, PUT")
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		state := levelsState{Level: l.Level().String(), Packages: map[string]string{}}
+		for name, level := range l.Packages() {
+			state.Packages[name] = level.String()
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(state)
+	})
+}
+
+// leveledLogger отбрасывает сообщения ниже уровня пакета или общего уровня
+type leveledLogger struct {
+	next   Logger
+	levels *Levels
+	name   string
+}
+
+func (l *leveledLogger) Debug(msg string, fields ...interface{}) {
+	if l.levels.Enabled(l.name, DebugLevel) {
+		l.next.Debug(msg, fields...)
+	}
+}
+
+func (l *leveledLogger) Info(msg string, fields ...interface{}) {
+	if l.levels.Enabled(l.name, InfoLevel) {
+		l.next.Info(msg, fields...)
+	}
+}
+
+func (l *leveledLogger) Warn(msg string, fields ...interface{}) {
+	if l.levels.Enabled(l.name, WarnLevel) {
+		l.next.Warn(msg, fields...)
+	}
+}
+
+func (l *leveledLogger) Error(msg string, fields ...interface{}) {
+	if l.levels.Enabled(l.name, ErrorLevel) {
+		l.next.Error(msg, fields...)
+	}
+}
+
+// Fatal пишется всегда
+func (l *leveledLogger) Fatal(msg string, fields ...interface{}) {
+	l.next.Fatal(msg, fields...)
+}
+
+func (l *leveledLogger) WithField(key string, value interface{}) Logger {
+	return &leveledLogger{next: l.next.WithField(key, value), levels: l.levels, name: l.name}
+}
+
+func (l *leveledLogger) WithFields(fields map[string]interface{}) Logger {
+	return &leveledLogger{next: l.next.WithFields(fields), levels: l.levels, name: l.name}
+}
+
+func (l *leveledLogger) WithContext(ctx context.Context) Logger {
+	return &leveledLogger{next: l.next.WithContext(ctx), levels: l.levels, name: l.name}
+}
+
+// Named добавляет поле logger, вложенные имена разделяются точкой
+func (l *leveledLogger) Named(name string) Logger {
+	if l.name != "" {
+		name = l.name + "." + name
+	}
+	return &leveledLogger{next: l.next.WithField("logger", name), levels: l.levels, name: name}
+}
+
+// multiLogger пишет сообщение во все выходы
+type multiLogger []Logger
+
+func (m multiLogger) Debug(msg string, fields ...interface{}) {
+	for _, l := range m {
+		l.Debug(msg, fields...)
+	}
+}
+
+func (m multiLogger) Info(msg string, fields ...interface{}) {
+	for _, l := range m {
+		l.Info(msg, fields...)
+	}
+}
+
+func (m multiLogger) Warn(msg string, fields ...interface{}) {
+	for _, l := range m {
+		l.Warn(msg, fields...)
+	}
+}
+
+func (m multiLogger) Error(msg string, fields ...interface{}) {
+	for _, l := range m {
+		l.Error(msg, fields...)
+	}
+}
+
+// Fatal пишет сообщение во все выходы и только затем завершает процесс
+func (m multiLogger) Fatal(msg string, fields ...interface{}) {
+	for _, l := range m {
+		l.Error(msg, fields...)
+	}
+	os.Exit(1)
+}
+
+func (m multiLogger) WithField(key string, value interface{}) Logger {
+	return m.each(func(l Logger) Logger { return l.WithField(key, value) })
+}
+
+func (m multiLogger) WithFields(fields map[string]interface{}) Logger {
+	return m.each(func(l Logger) Logger { return l.WithFields(fields) })
+}
+
+func (m multiLogger) WithContext(ctx context.Context) Logger {
+	return m.each(func(l Logger) Logger { return l.WithContext(ctx) })
+}
+
+func (m multiLogger) Named(name string) Logger {
+	return m.each(func(l Logger) Logger { return l.Named(name) })
+}
+
+func (m multiLogger) each(apply func(Logger) Logger) Logger {
+	result := make(multiLogger, len(m))
+	for i, l := range m {
+		result[i] = apply(l)
+	}
+	return result
+}
+`
+}
+
+// generateLogrusLogger генерирует реализацию Logger на logrus
+func (g *Generator) generateLogrusLogger() string {
+	return `package logger
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+)
+
+// LogrusLogger реализация Logger на основе logrus
+type LogrusLogger struct {
+	entry *logrus.Entry
+}
+
+// newLogger создает logrus логгер для одного выхода
+func newLogger(opts sinkOptions) Logger {
+	log := logrus.New()
+
+	// Устанавливаем уровень
+	level, err := logrus.ParseLevel(opts.Level)
+	if err != nil {
+		level = logrus.InfoLevel
+	}
+	log.SetLevel(level)
+
+	// Устанавливаем формат
+	switch opts.Format {
+	case "json":
+		log.SetFormatter(&logrus.JSONFormatter{})
+	default:
+		log.SetFormatter(&logrus.TextFormatter{
+			FullTimestamp: true,
+			DisableColors: !opts.Console,
+		})
+	}
+
+	log.SetOutput(opts.Out)
+
+	return &LogrusLogger{
+		entry: logrus.NewEntry(log),
+	}
+}
+
+// Debug логирует отладочное сообщение
+func (l *LogrusLogger) Debug(msg string, fields ...interface{}) {
+	l.entry.WithFields(l.parseFields(fields...)).Debug(msg)
+}
+
+// Info логирует информационное сообщение
+func (l *LogrusLogger) Info(msg string, fields ...interface{}) {
+	l.entry.WithFields(l.parseFields(fields...)).Info(msg)
+}
+
+// Warn логирует предупреждение
+func (l *LogrusLogger) Warn(msg string, fields ...interface{}) {
+	l.entry.WithFields(l.parseFields(fields...)).Warn(msg)
+}
+
+// Error логирует ошибку
+func (l *LogrusLogger) Error(msg string, fields ...interface{}) {
+	l.entry.WithFields(l.parseFields(fields...)).Error(msg)
+}
+
+// Fatal логирует фатальную ошибку
+func (l *LogrusLogger) Fatal(msg string, fields ...interface{}) {
+	l.entry.WithFields(l.parseFields(fields...)).Fatal(msg)
+}
+
+// WithField добавляет поле к логгеру
+func (l *LogrusLogger) WithField(key string, value interface{}) Logger {
+	return &LogrusLogger{
+		entry: l.entry.WithField(key, value),
+	}
+}
+
+// WithFields добавляет поля к логгеру
+func (l *LogrusLogger) WithFields(fields map[string]interface{}) Logger {
+	return &LogrusLogger{
+		entry: l.entry.WithFields(fields),
+	}
+}
+
+// WithContext добавляет к логгеру поля корреляции из контекста
+func (l *LogrusLogger) WithContext(ctx context.Context) Logger {
+	fields := contextFields(ctx)
+	if len(fields) == 0 {
+		return l
+	}
+	return &LogrusLogger{
+		entry: l.entry.WithFields(l.parseFields(fields...)),
+	}
+}
+
+// Named добавляет имя логгера
+func (l *LogrusLogger) Named(name string) Logger {
+	return l.WithField("logger", name)
+}
+
+// parseFields парсит поля из slice
+func (l *LogrusLogger) parseFields(fields ...interface{}) logrus.Fields {
+	parsed := make(logrus.Fields)
+
+	for i := 0; i < len(fields); i += 2 {
+		if i+1 < len(fields) {
+			key := fmt.Sprintf("%v", fields[i])
+			parsed[key] = fields[i+1]
+		}
+	}
+
+	return parsed
+}
+`
+}
+
+// generateSlogLogger генерирует реализацию Logger на log/slog
+func (g *Generator) generateSlogLogger() string {
+	return `package logger
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+// SlogLogger реализация Logger на основе log/slog
+type SlogLogger struct {
+	logger *slog.Logger
+}
+
+// newLogger создает slog логгер для одного выхода
+func newLogger(opts sinkOptions) Logger {
+	handlerOpts := &slog.HandlerOptions{Level: slogLevel(opts.Level)}
+
+	var handler slog.Handler
+	switch opts.Format {
+	case "json":
+		handler = slog.NewJSONHandler(opts.Out, handlerOpts)
+	default:
+		handler = slog.NewTextHandler(opts.Out, handlerOpts)
+	}
+
+	return &SlogLogger{logger: slog.New(handler)}
+}
+
+// slogLevel переводит уровень из конфигурации, fatal и panic соответствуют error
+func slogLevel(level string) slog.Level {
+	switch strings.ToLower(level) {
+	case "fatal", "panic":
+		return slog.LevelError
+	case "warning":
+		return slog.LevelWarn
+	}
+
+	var parsed slog.Level
+	if err := parsed.UnmarshalText([]byte(level)); err != nil {
+		return slog.LevelInfo
+	}
+	return parsed
+}
+
+// Debug логирует отладочное сообщение
+func (l *SlogLogger) Debug(msg string, fields ...interface{}) {
+	l.logger.Debug(msg, fields...)
+}
+
+// Info логирует информационное сообщение
+func (l *SlogLogger) Info(msg string, fields ...interface{}) {
+	l.logger.Info(msg, fields...)
+}
+
+// Warn логирует предупреждение
+func (l *SlogLogger) Warn(msg string, fields ...interface{}) {
+	l.logger.Warn(msg, fields...)
+}
+
+// Error логирует ошибку
+func (l *SlogLogger) Error(msg string, fields ...interface{}) {
+	l.logger.Error(msg, fields...)
+}
+
+// Fatal логирует фатальную ошибку и завершает процесс
+func (l *SlogLogger) Fatal(msg string, fields ...interface{}) {
+	l.logger.Error(msg, fields...)
+	os.Exit(1)
+}
+
+// WithField добавляет поле к логгеру
+func (l *SlogLogger) WithField(key string, value interface{}) Logger {
+	return &SlogLogger{logger: l.logger.With(key, value)}
+}
+
+// WithFields добавляет поля к логгеру
+func (l *SlogLogger) WithFields(fields map[string]interface{}) Logger {
+	return &SlogLogger{logger: l.logger.With(mapFields(fields)...)}
+}
+
+// WithContext добавляет к логгеру поля корреляции из контекста
+func (l *SlogLogger) WithContext(ctx context.Context) Logger {
+	fields := contextFields(ctx)
+	if len(fields) == 0 {
+		return l
+	}
+	return &SlogLogger{logger: l.logger.With(fields...)}
+}
+
+// Named добавляет имя логгера
+func (l *SlogLogger) Named(name string) Logger {
+	return l.WithField("logger", name)
+}
+`
+}
+
+// generateZapLogger генерирует реализацию Logger на zap
+func (g *Generator) generateZapLogger() string {
+	return `package logger
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// ZapLogger реализация Logger на основе zap
+type ZapLogger struct {
+	logger *zap.SugaredLogger
+}
+
+// newLogger создает zap логгер для одного выхода
+func newLogger(opts sinkOptions) Logger {
+	level, err := zapcore.ParseLevel(opts.Level)
+	if err != nil {
+		level = zapcore.InfoLevel
+	}
+
+	encoderConfig := zap.NewProductionEncoderConfig()
+	encoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
+
+	var encoder zapcore.Encoder
+	switch opts.Format {
+	case "json":
+		encoder = zapcore.NewJSONEncoder(encoderConfig)
+	default:
+		encoderConfig.EncodeLevel = zapcore.CapitalLevelEncoder
+		encoder = zapcore.NewConsoleEncoder(encoderConfig)
+	}
+
+	core := zapcore.NewCore(encoder, zapcore.AddSync(opts.Out), level)
+	logger := zap.New(core, zap.AddCaller(), zap.AddCallerSkip(opts.CallerSkip))
+
+	return &ZapLogger{logger: logger.Sugar()}
+}
+
+// Debug логирует отладочное сообщение
+func (l *ZapLogger) Debug(msg string, fields ...interface{}) {
+	l.logger.Debugw(msg, fields...)
+}
+
+// Info логирует информационное сообщение
+func (l *ZapLogger) Info(msg string, fields ...interface{}) {
+	l.logger.Infow(msg, fields...)
+}
+
+// Warn логирует предупреждение
+func (l *ZapLogger) Warn(msg string, fields ...interface{}) {
+	l.logger.Warnw(msg, fields...)
+}
+
+// Error логирует ошибку
+func (l *ZapLogger) Error(msg string, fields ...interface{}) {
+	l.logger.Errorw(msg, fields...)
+}
+
+// Fatal логирует фатальную ошибку и завершает процесс
+func (l *ZapLogger) Fatal(msg string, fields ...interface{}) {
+	l.logger.Fatalw(msg, fields...)
+}
+
+// WithField добавляет поле к логгеру
+func (l *ZapLogger) WithField(key string, value interface{}) Logger {
+	return &ZapLogger{logger: l.logger.With(key, value)}
+}
+
+// WithFields добавляет поля к логгеру
+func (l *ZapLogger) WithFields(fields map[string]interface{}) Logger {
+	return &ZapLogger{logger: l.logger.With(mapFields(fields)...)}
+}
+
+// WithContext добавляет к логгеру поля корреляции из контекста
+func (l *ZapLogger) WithContext(ctx context.Context) Logger {
+	fields := contextFields(ctx)
+	if len(fields) == 0 {
+		return l
+	}
+	return &ZapLogger{logger: l.logger.With(fields...)}
+}
+
+// Named добавляет имя логгера
+func (l *ZapLogger) Named(name string) Logger {
+	return l.WithField("logger", name)
+}
+`
+}
+
+// generateZerologLogger генерирует реализацию Logger на zerolog
+func (g *Generator) generateZerologLogger() string {
+	return `package logger
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// ZerologLogger реализация Logger на основе zerolog
+type ZerologLogger struct {
+	logger zerolog.Logger
+}
+
+// newLogger создает zerolog логгер для одного выхода
+func newLogger(opts sinkOptions) Logger {
+	level, err := zerolog.ParseLevel(opts.Level)
+	if err != nil || level == zerolog.NoLevel {
+		level = zerolog.InfoLevel
+	}
+
+	out := opts.Out
+	if opts.Format != "json" {
+		out = zerolog.ConsoleWriter{Out: out, TimeFormat: time.RFC3339, NoColor: !opts.Console}
+	}
+
+	return &ZerologLogger{
+		logger: zerolog.New(out).Level(level).With().Timestamp().Logger(),
+	}
+}
+
+// Debug логирует отладочное сообщение
+func (l *ZerologLogger) Debug(msg string, fields ...interface{}) {
+	l.logger.Debug().Fields(fields).Msg(msg)
+}
+
+// Info логирует информационное сообщение
+func (l *ZerologLogger) Info(msg string, fields ...interface{}) {
+	l.logger.Info().Fields(fields).Msg(msg)
+}
+
+// Warn логирует предупреждение
+func (l *ZerologLogger) Warn(msg string, fields ...interface{}) {
+	l.logger.Warn().Fields(fields).Msg(msg)
+}
+
+// Error логирует ошибку
+func (l *ZerologLogger) Error(msg string, fields ...interface{}) {
+	l.logger.Error().Fields(fields).Msg(msg)
+}
+
+// Fatal логирует фатальную ошибку и завершает процесс
+func (l *ZerologLogger) Fatal(msg string, fields ...interface{}) {
+	l.logger.Fatal().Fields(fields).Msg(msg)
+}
+
+// WithField добавляет поле к логгеру
+func (l *ZerologLogger) WithField(key string, value interface{}) Logger {
+	return &ZerologLogger{logger: l.logger.With().Interface(key, value).Logger()}
+}
+
+// WithFields добавляет поля к логгеру
+func (l *ZerologLogger) WithFields(fields map[string]interface{}) Logger {
+	return &ZerologLogger{logger: l.logger.With().Fields(fields).Logger()}
+}
+
+// WithContext добавляет к логгеру поля корреляции из контекста
+func (l *ZerologLogger) WithContext(ctx context.Context) Logger {
+	fields := contextFields(ctx)
+	if len(fields) == 0 {
+		return l
+	}
+	return &ZerologLogger{logger: l.logger.With().Fields(fields).Logger()}
+}
+
+// Named добавляет имя логгера
+func (l *ZerologLogger) Named(name string) Logger {
+	return l.WithField("logger", name)
+}
+`
+}
+
+// generateLoggerTest генерирует тесты логгера, общие для всех backend
+func (g *Generator) generateLoggerTest(config *ProjectConfig) string {
+	return `package logger
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"` + config.ModuleName + `/pkg/requestid"
+)
+
+// recordingLogger запоминает сообщения, остальные методы не используются
+type recordingLogger struct {
+	Logger
+	lines []string
+}
+
+func (r *recordingLogger) Info(msg string, fields ...interface{}) {
+	r.lines = append(r.lines, "info: "+msg)
+}
+
+func (r *recordingLogger) Error(msg string, fields ...interface{}) {
+	r.lines = append(r.lines, "error: "+msg)
+}
+
+func TestWriterLogsEachLine(t *testing.T) {
+	rec := &recordingLogger{}
+	w := NewWriter(rec, "error")
+
+	n, err := w.Write([]byte("first\n\n  second  \n"))
+	if err != nil || n != 18 {
+		t.Fatalf("unexpected write result: %d, %v", n, err)
+	}
+
+	expected := []string{"error: first", "error: second"}
+	if !reflect.DeepEqual(rec.lines, expected) {
+		t.Errorf("lines = %v, want %v", rec.lines, expected)
+	}
+}
+
+func TestContextFields(t *testing.T) {
+	if fields := contextFields(context.Background()); len(fields) != 0 {
+		t.Errorf("expected no fields for empty context, got %v", fields)
+	}
+
+	ctx := requestid.NewContext(context.Background(), "req-1")
+	fields := contextFields(ctx)
+	if len(fields) < 2 || fields[0] != "request_id" || fields[1] != "req-1" {
+		t.Errorf("expected request_id field, got %v", fields)
+	}
+}
+
+func TestLevelsPackageOverrides(t *testing.T) {
+	levels := NewLevels("info", map[string]string{"repository": "warn", "repository.audit": "debug"})
+
+	cases := []struct {
+		name     string
+		level    Level
+		expected bool
+	}{
+		{"", DebugLevel, false},
+		{"", InfoLevel, true},
+		{"repository", InfoLevel, false},
+		{"repository.user", InfoLevel, false},
+		{"repository.user", WarnLevel, true},
+		{"repository.audit", DebugLevel, true},
+		{"handlers", InfoLevel, true},
+	}
+	for _, c := range cases {
+		if got := levels.Enabled(c.name, c.level); got != c.expected {
+			t.Errorf("Enabled(%q, %s) = %v, want %v", c.name, c.level, got, c.expected)
+		}
+	}
+}
+
+func TestLevelsHandler(t *testing.T) {
+	levels := NewLevels("info", nil)
+	handler := levels.Handler()
+
+	requests := []string{` + "`" + `{"level":"debug"}` + "`" + `, ` + "`" + `{"package":"repository","level":"error"}` + "`" + `}
+	for _, body := range requests {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/log/level", strings.NewReader(body)))
+		if rec.Code != http.StatusOK {
+			t.Fatalf("PUT %s: status %d", body, rec.Code)
+		}
+	}
+
+	if levels.Level() != DebugLevel || levels.Packages()["repository"] != ErrorLevel {
+		t.Errorf("levels not applied: %s, %v", levels.Level(), levels.Packages())
+	}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/log/level", strings.NewReader(` + "`" + `{"level":"verbose"}` + "`" + `)))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("expected 400 for unknown level, got %d", rec.Code)
+	}
+}
+`
+}
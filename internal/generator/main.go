@@ -36,37 +36,108 @@ func (g *Generator) generateMainFiles(config *ProjectConfig) error {
 func (g *Generator) generateMainGo(config *ProjectConfig) error {
 	content := fmt.Sprintf(`package main
 
-import (
+import (%s
+	"flag"%s
 	"log"
 
-	"%s/internal/config"
+	"%s/internal/admin"
 	"%s/internal/app"
+	"%s/internal/config"%s
+)
+
+// Версия сборки, задается через -ldflags "-X main.version=... -X main.commit=... -X main.buildTime=..."
+var (
+	version   = "dev"
+	commit    = "none"
+	buildTime = ""
 )
 
 // @title %s API
 // @version 1.0
 // @description API документация для %s
 // @host localhost:8080
-// @BasePath /api/v1
+// @BasePath /api/v1%s
 func main() {
-	// Загружаем конфигурацию
-	cfg, err := config.Load("config.yaml")
+	configPath := flag.String("config", "config.yaml", "путь к файлу конфигурации")
+	flag.Parse()
+%s
+	// Загружаем конфигурацию: файл, профиль APP_ENV, .env и переменные APP_*
+	cfg, err := config.Load(*configPath)
 	if err != nil {
 		log.Fatalf("Ошибка загрузки конфигурации: %%v", err)
 	}
 
 	// Создаем и запускаем приложение
-	application := app.New(cfg)
+	application := app.New(cfg, admin.BuildInfo{Version: version, Commit: commit, BuildTime: buildTime})
 	if err := application.Run(); err != nil {
 		log.Fatalf("Ошибка запуска приложения: %%v", err)
 	}
 }
-`, config.ModuleName, config.ModuleName, config.Name, config.Name)
+`, mainStdImports(config), mainFmtImport(config), config.ModuleName, config.ModuleName, config.ModuleName, mainDatabaseImport(config), config.Name, config.Name, swaggerSecurity(config), mainMigrateDispatch(config))
+
+	if hasMigrations(config) {
+		content += migrateCommand(config)
+	}
 
 	mainPath := filepath.Join(g.projectPath, "cmd/main.go")
 	return os.WriteFile(mainPath, []byte(content), 0644)
 }
 
+// mainStdImports возвращает дополнительные импорты стандартной библиотеки для cmd/main.go
+func mainStdImports(config *ProjectConfig) string {
+	if !hasMigrations(config) {
+		return ""
+	}
+	return `
+	"context"
+	"errors"`
+}
+
+// mainFmtImport возвращает импорт fmt для подкоманды migrate
+func mainFmtImport(config *ProjectConfig) string {
+	if !hasMigrations(config) {
+		return ""
+	}
+	return `
+	"fmt"`
+}
+
+// mainDatabaseImport возвращает импорт pkg/database для подкоманды migrate
+func mainDatabaseImport(config *ProjectConfig) string {
+	if !hasMigrations(config) {
+		return ""
+	}
+	return `
+	"` + config.ModuleName + `/pkg/database"`
+}
+
+// mainMigrateDispatch вызывает подкоманду migrate до запуска приложения
+func mainMigrateDispatch(config *ProjectConfig) string {
+	if !hasMigrations(config) {
+		return ""
+	}
+	return `
+	// Подкоманда migrate: ./main migrate up
+	if flag.Arg(0) == "migrate" {
+		if err := runMigrate(*configPath, flag.Args()[1:]); err != nil {
+			log.Fatalf("Ошибка миграции: %v", err)
+		}
+		return
+	}
+`
+}
+
+// swaggerSecurity возвращает описание схемы авторизации для Swagger
+func swaggerSecurity(config *ProjectConfig) string {
+	if !config.EnableAuth {
+		return ""
+	}
+	return `
+// @securityDefinitions.apikey BearerAuth
+// @in header
+// @name Authorization`
+}
+
 // generateAppGo создает файл internal/app/app.go
 func (g *Generator) generateAppGo(config *ProjectConfig) error {
 	var content string
@@ -91,21 +162,46 @@ func (g *Generator) generateFiberApp(config *ProjectConfig) string {
 	content := fmt.Sprintf(`package app
 
 import (
+	"context"
+	"crypto/tls"
 	"fmt"
+	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
+	"%s/internal/admin"
 	"%s/internal/config"
 	"%s/internal/handlers"
-	"%s/pkg/logger"`, config.ModuleName, config.ModuleName, config.ModuleName)
+	"%s/pkg/health"
+	"%s/pkg/logger"
+	"%s/pkg/metrics"
+	"%s/pkg/ratelimit"
+	"%s/pkg/tlsutil"`, config.ModuleName, config.ModuleName, config.ModuleName, config.ModuleName, config.ModuleName, config.ModuleName, config.ModuleName, config.ModuleName)
+
+	if config.EnableAuth {
+		content += fmt.Sprintf(`
+	"%s/pkg/auth"`, config.ModuleName)
+	}
+
+	if hasCache(config) {
+		content += fmt.Sprintf(`
+	"%s/pkg/cache"`, config.ModuleName)
+	}
 
 	if !strings.Contains(strings.ToLower(config.Database), "без") {
 		content += fmt.Sprintf(`
 	"%s/pkg/database"`, config.ModuleName)
 	}
 
+	if config.EnableOTel {
+		content += fmt.Sprintf(`
+	"%s/pkg/tracing"`, config.ModuleName)
+	}
+
 	content += `
 )
 
@@ -113,27 +209,32 @@ import (
 type App struct {
 	cfg    *config.Config
 	logger logger.Logger
+	logs   *logger.Root
+	build  admin.BuildInfo
 	app    *fiber.App
 }
 
 // New создает новое приложение
-func New(cfg *config.Config) *App {
+func New(cfg *config.Config, build admin.BuildInfo) *App {
 	// Инициализируем логгер
-	loggerConfig := logger.LoggerConfig{
-		Level:  cfg.Logger.Level,
-		Format: cfg.Logger.Format,
-		Output: cfg.Logger.Output,
-	}
-	log := logger.New(loggerConfig)
+	logs := logger.New(loggerConfig(cfg.Logger))
 
 	return &App{
 		cfg:    cfg,
-		logger: log,
+		logger: logs,
+		logs:   logs,
+		build:  build,
 	}
 }
 
 // Run запускает приложение
-func (a *App) Run() error {`
+func (a *App) Run() error {
+	defer a.logs.Close()
+`
+
+	if config.EnableOTel {
+		content += tracingSetup
+	}
 
 	if !strings.Contains(strings.ToLower(config.Database), "без") {
 		content += `
@@ -145,22 +246,65 @@ func (a *App) Run() error {`
 	defer db.Close()
 	
 	a.logger.Info("Подключение к базе данных установлено")
+` + autoMigrateSetup(config)
+	}
 
-	// Создаем Fiber приложение
-	handler := handlers.New(a.cfg, a.logger, db)`
-	} else {
+	if config.EnableAuth {
 		content += `
-	// Создаем Fiber приложение
-	handler := handlers.New(a.cfg, a.logger)`
+	// Инициализируем проверку токенов
+	var verifier *auth.Verifier
+	if a.cfg.Auth.Enabled {
+		v, err := auth.NewVerifier(a.cfg.Auth)
+		if err != nil {
+			return fmt.Errorf("ошибка настройки аутентификации: %w", err)
+		}
+		verifier = v
+	}
+`
 	}
 
+	if hasCache(config) {
+		content += cacheSetup
+	}
+
+	content += rateLimiterSetup
+	content += metricsSetup(config)
+	content += healthSetup(config)
+	content += adminSetup
+
+	content += `
+	// Создаем Fiber приложение
+	handler := handlers.New(` + handlerArgs(config) + `)`
+
 	content += `
 	a.app = handler.SetupRoutes()
 
+	ln, err := net.Listen("tcp", fmt.Sprintf(":%d", a.cfg.App.Port))
+	if err != nil {
+		return fmt.Errorf("ошибка создания listener: %w", err)
+	}
+
+	// Включаем TLS если настроен
+	watchCtx, stopWatch := context.WithCancel(context.Background())
+	defer stopWatch()
+
+	if a.cfg.App.TLS.Enabled {
+		reloader, err := tlsutil.NewReloader(a.cfg.App.TLS, a.logger)
+		if err != nil {
+			return fmt.Errorf("ошибка настройки TLS: %w", err)
+		}
+		go reloader.Watch(watchCtx, 10*time.Second)
+		ln = tls.NewListener(ln, reloader.ServerConfig())
+	}
+` + configReloadSetup + `
+	// Порт уже занят listener, сервис готов принимать запросы
+	checks.MarkStarted()
+
 	// Запускаем Fiber сервер в горутине
 	go func() {
-		a.logger.Info("Запуск HTTP сервера", "port", a.cfg.App.Port)
-		if err := a.app.Listen(fmt.Sprintf(":%d", a.cfg.App.Port)); err != nil {
+		a.logger.Info("Запуск HTTP сервера", "port", a.cfg.App.Port, "tls", a.cfg.App.TLS.Enabled)
+		if err := a.app.Listener(ln); err != nil {
+			checks.MarkStopped()
 			a.logger.Error("Ошибка HTTP сервера", "error", err)
 		}
 	}()
@@ -180,7 +324,7 @@ func (a *App) Run() error {`
 	a.logger.Info("Сервер успешно остановлен")
 	return nil
 }
-`
+` + loggerConfigMapping
 
 	return content
 }
@@ -192,21 +336,43 @@ func (g *Generator) generateStandardApp(config *ProjectConfig) string {
 import (
 	"context"
 	"fmt"
+	"log"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
+	"%s/internal/admin"
 	"%s/internal/config"
 	"%s/internal/handlers"
-	"%s/pkg/logger"`, config.ModuleName, config.ModuleName, config.ModuleName)
+	"%s/pkg/health"
+	"%s/pkg/logger"
+	"%s/pkg/metrics"
+	"%s/pkg/ratelimit"
+	"%s/pkg/tlsutil"`, config.ModuleName, config.ModuleName, config.ModuleName, config.ModuleName, config.ModuleName, config.ModuleName, config.ModuleName, config.ModuleName)
+
+	if config.EnableAuth {
+		content += fmt.Sprintf(`
+	"%s/pkg/auth"`, config.ModuleName)
+	}
+
+	if hasCache(config) {
+		content += fmt.Sprintf(`
+	"%s/pkg/cache"`, config.ModuleName)
+	}
 
 	if !strings.Contains(strings.ToLower(config.Database), "без") {
 		content += fmt.Sprintf(`
 	"%s/pkg/database"`, config.ModuleName)
 	}
 
+	if config.EnableOTel {
+		content += fmt.Sprintf(`
+	"%s/pkg/tracing"`, config.ModuleName)
+	}
+
 	content += `
 )
 
@@ -214,27 +380,32 @@ import (
 type App struct {
 	cfg    *config.Config
 	logger logger.Logger
+	logs   *logger.Root
+	build  admin.BuildInfo
 	server *http.Server
 }
 
 // New создает новое приложение
-func New(cfg *config.Config) *App {
+func New(cfg *config.Config, build admin.BuildInfo) *App {
 	// Инициализируем логгер
-	loggerConfig := logger.LoggerConfig{
-		Level:  cfg.Logger.Level,
-		Format: cfg.Logger.Format,
-		Output: cfg.Logger.Output,
-	}
-	log := logger.New(loggerConfig)
+	logs := logger.New(loggerConfig(cfg.Logger))
 
 	return &App{
 		cfg:    cfg,
-		logger: log,
+		logger: logs,
+		logs:   logs,
+		build:  build,
 	}
 }
 
 // Run запускает приложение
-func (a *App) Run() error {`
+func (a *App) Run() error {
+	defer a.logs.Close()
+`
+
+	if config.EnableOTel {
+		content += tracingSetup
+	}
 
 	if !strings.Contains(strings.ToLower(config.Database), "без") {
 		content += `
@@ -246,29 +417,78 @@ func (a *App) Run() error {`
 	defer db.Close()
 	
 	a.logger.Info("Подключение к базе данных установлено")
+` + autoMigrateSetup(config)
+	}
 
-	// Создаем HTTP сервер
-	handler := handlers.New(a.cfg, a.logger, db)`
-	} else {
+	if config.EnableAuth {
 		content += `
-	// Создаем HTTP сервер
-	handler := handlers.New(a.cfg, a.logger)`
+	// Инициализируем проверку токенов
+	var verifier *auth.Verifier
+	if a.cfg.Auth.Enabled {
+		v, err := auth.NewVerifier(a.cfg.Auth)
+		if err != nil {
+			return fmt.Errorf("ошибка настройки аутентификации: %w", err)
+		}
+		verifier = v
+	}
+`
 	}
 
+	if hasCache(config) {
+		content += cacheSetup
+	}
+
+	content += rateLimiterSetup
+	content += metricsSetup(config)
+	content += healthSetup(config)
+	content += adminSetup
+
+	content += `
+	// Создаем HTTP сервер
+	handler := handlers.New(` + handlerArgs(config) + `)`
+
 	content += `
 	
 	a.server = &http.Server{
 		Addr:         fmt.Sprintf(":%d", a.cfg.App.Port),
 		Handler:      handler.SetupRoutes(),
+		ErrorLog:     log.New(logger.NewWriter(a.logger, "error"), "", 0),
 		ReadTimeout:  15 * time.Second,
 		WriteTimeout: 15 * time.Second,
 		IdleTimeout:  60 * time.Second,
 	}
 
+	// Включаем TLS если настроен
+	watchCtx, stopWatch := context.WithCancel(context.Background())
+	defer stopWatch()
+
+	if a.cfg.App.TLS.Enabled {
+		reloader, err := tlsutil.NewReloader(a.cfg.App.TLS, a.logger)
+		if err != nil {
+			return fmt.Errorf("ошибка настройки TLS: %w", err)
+		}
+		go reloader.Watch(watchCtx, 10*time.Second)
+		a.server.TLSConfig = reloader.ServerConfig()
+	}
+` + configReloadSetup + `
+	// Занимаем порт до отметки о запуске: если он занят, сервис не становится готовым
+	ln, err := net.Listen("tcp", a.server.Addr)
+	if err != nil {
+		return fmt.Errorf("ошибка создания listener: %w", err)
+	}
+	checks.MarkStarted()
+
 	// Запускаем HTTP сервер в горутине
 	go func() {
-		a.logger.Info("Запуск HTTP сервера", "port", a.cfg.App.Port)
-		if err := a.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		a.logger.Info("Запуск HTTP сервера", "port", a.cfg.App.Port, "tls", a.cfg.App.TLS.Enabled)
+		var err error
+		if a.server.TLSConfig != nil {
+			err = a.server.ServeTLS(ln, "", "")
+		} else {
+			err = a.server.Serve(ln)
+		}
+		if err != nil && err != http.ErrServerClosed {
+			checks.MarkStopped()
 			a.logger.Error("Ошибка HTTP сервера", "error", err)
 		}
 	}()
@@ -291,11 +511,211 @@ func (a *App) Run() error {`
 	a.logger.Info("Сервер успешно остановлен")
 	return nil
 }
+` + loggerConfigMapping
+
+	return content
+}
+
+// tracingSetup инициализация OpenTelemetry в App.Run
+const tracingSetup = `
+	// Инициализируем трассировку
+	shutdownTracing, err := tracing.Init(context.Background(), a.cfg.Tracing, a.cfg.App)
+	if err != nil {
+		return fmt.Errorf("ошибка настройки трассировки: %w", err)
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			a.logger.Error("Ошибка остановки трассировки", "error", err)
+		}
+	}()
+`
+
+// cacheSetup подключение к кэшу в App.Run
+const cacheSetup = `
+	// Инициализируем кэш
+	store, err := cache.New(a.cfg.Cache)
+	if err != nil {
+		return fmt.Errorf("ошибка подключения к кэшу: %w", err)
+	}
+	defer store.Close()
+`
+
+// rateLimiterSetup инициализация limiter в App.Run
+const rateLimiterSetup = `
+	// Инициализируем ограничение частоты запросов
+	var limiter ratelimit.Limiter
+	if a.cfg.Middleware.RateLimit.Enabled {
+		l, err := ratelimit.New(a.cfg.Middleware.RateLimit)
+		if err != nil {
+			return fmt.Errorf("ошибка настройки rate limiting: %w", err)
+		}
+		defer l.Close()
+		limiter = l
+	}
+`
+
+// metricsSetup инициализация Prometheus метрик и отдельного admin порта в App.Run
+func metricsSetup(config *ProjectConfig) string {
+	content := `
+	// Инициализируем метрики
+	registry := metrics.New()`
+
+	if !strings.Contains(strings.ToLower(config.Database), "без") {
+		content += `
+	registry.RegisterDatabase(db)`
+	}
+
+	content += `
+	if a.cfg.Metrics.Enabled && a.cfg.Metrics.Port != 0 {
+		metricsServer := metrics.NewServer(a.cfg.Metrics, registry)
+		go func() {
+			a.logger.Info("Запуск сервера метрик", "port", a.cfg.Metrics.Port, "path", a.cfg.Metrics.Path)
+			if err := metricsServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				a.logger.Error("Ошибка сервера метрик", "error", err)
+			}
+		}()
+		defer metricsServer.Close()
+	}
+`
+	return content
+}
+
+// adminSetup запуск admin сервера в App.Run
+const adminSetup = `
+	// Запускаем admin сервер
+	if a.cfg.Admin.Enabled {
+		if a.cfg.Admin.Token == "" {
+			a.logger.Warn("admin.token не задан, admin сервер доступен без аутентификации")
+		}
+		adminServer := admin.New(a.cfg, a.build, a.logs.Levels())
+		go func() {
+			a.logger.Info("Запуск admin сервера", "port", a.cfg.Admin.Port, "version", a.build.Version)
+			if err := adminServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				a.logger.Error("Ошибка admin сервера", "error", err)
+			}
+		}()
+		defer adminServer.Close()
+	}
+`
+
+// configReloadSetup применяет перезагруженные уровни логирования и запускает отслеживание файлов конфигурации
+const configReloadSetup = `
+	// Перечитываем конфигурацию без перезапуска
+	a.cfg.Changes().Logger.Subscribe(func(cfg config.LoggerConfig) {
+		a.logs.Levels().Reset(cfg.Level, cfg.Packages)
+	})
+	if a.cfg.HotReload.Enabled {
+		go a.cfg.Watch(watchCtx, time.Duration(a.cfg.HotReload.Interval)*time.Second, func(err error) {
+			if err != nil {
+				a.logger.Error("Конфигурация не перезагружена, действуют прежние значения", "error", err)
+				return
+			}
+			a.logger.Info("Конфигурация перезагружена")
+		})
+	}
+`
+
+// loggerConfigMapping переводит конфигурацию логгера из config в pkg/logger
+const loggerConfigMapping = `
+// loggerConfig переводит секцию logger конфигурации в logger.LoggerConfig
+func loggerConfig(cfg config.LoggerConfig) logger.LoggerConfig {
+	sinks := make([]logger.SinkConfig, 0, len(cfg.Sinks))
+	for _, sink := range cfg.Sinks {
+		sinks = append(sinks, logger.SinkConfig{
+			Output: sink.Output,
+			Format: sink.Format,
+			Level:  sink.Level,
+			File:   loggerFileConfig(sink.File),
+		})
+	}
+
+	return logger.LoggerConfig{
+		Level:    cfg.Level,
+		Format:   cfg.Format,
+		Output:   cfg.Output,
+		File:     loggerFileConfig(cfg.File),
+		Sinks:    sinks,
+		Packages: cfg.Packages,
+	}
+}
+
+func loggerFileConfig(cfg config.LoggerFileConfig) logger.FileConfig {
+	return logger.FileConfig{
+		Path:       cfg.Path,
+		MaxSizeMB:  cfg.MaxSizeMB,
+		MaxAgeDays: cfg.MaxAgeDays,
+		MaxBackups: cfg.MaxBackups,
+		Compress:   cfg.Compress,
+	}
+}
 `
 
+// healthSetup регистрация проверок состояния в App.Run
+func healthSetup(config *ProjectConfig) string {
+	content := `
+	// Регистрируем проверки для /livez, /readyz и /startupz
+	checks := health.New(a.cfg.Health)`
+
+	if !strings.Contains(strings.ToLower(config.Database), "без") {
+		content += `
+	checks.Register(health.Database(db))`
+	}
+
+	if supportsReplicas(config) {
+		content += `
+	for _, checker := range health.Replicas(db) {
+		checks.Register(checker)
+	}`
+	}
+
+	content += `
+	if redisLimiter, ok := limiter.(*ratelimit.RedisLimiter); ok {
+		checks.Register(health.Redis(redisLimiter.Client()))
+	}
+`
+
+	if hasCache(config) {
+		content += `	if redisCache, ok := store.(*cache.RedisCache); ok {
+		checks.Register(health.NewChecker("cache", health.Redis(redisCache.Client()).Check))
+	}
+`
+	}
 	return content
 }
 
+// autoMigrateSetup применяет миграции при запуске, если включен database.auto_migrate
+func autoMigrateSetup(config *ProjectConfig) string {
+	if !hasMigrations(config) {
+		return ""
+	}
+	return `
+	// Применяем миграции схемы
+	if a.cfg.Database.AutoMigrate {
+		if err := db.Migrate(); err != nil {
+			return fmt.Errorf("ошибка применения миграций: %w", err)
+		}
+		a.logger.Info("Миграции БД применены")
+	}
+`
+}
+
+// handlerArgs возвращает аргументы handlers.New в зависимости от выбранных опций
+func handlerArgs(config *ProjectConfig) string {
+	args := "a.cfg, a.logger"
+	if !strings.Contains(strings.ToLower(config.Database), "без") {
+		args += ", db"
+	}
+	if config.EnableAuth {
+		args += ", verifier"
+	}
+	if hasCache(config) {
+		args += ", store"
+	}
+	return args + ", limiter, registry, checks"
+}
+
 // generateContext создает файл pkg/context/context.go
 func (g *Generator) generateContext(config *ProjectConfig) error {
 	content := fmt.Sprintf(`package context
@@ -312,9 +732,24 @@ type AppContext struct {
 	ctx    context.Context
 	logger logger.Logger
 	userID string
+	roles  []string
 	traceID string
 }
 
+// appContextKey ключ для хранения AppContext в context.Context
+type appContextKey struct{}
+
+// ToContext сохраняет AppContext в context.Context
+func ToContext(ctx context.Context, appCtx *AppContext) context.Context {
+	return context.WithValue(ctx, appContextKey{}, appCtx)
+}
+
+// FromContext извлекает AppContext из context.Context
+func FromContext(ctx context.Context) (*AppContext, bool) {
+	appCtx, ok := ctx.Value(appContextKey{}).(*AppContext)
+	return appCtx, ok
+}
+
 // New создает новый контекст приложения
 func New(ctx context.Context, logger logger.Logger) *AppContext {
 	return &AppContext{
@@ -345,10 +780,35 @@ func (c *AppContext) UserID() string {
 	return c.userID
 }
 
-// WithTraceID устанавливает ID трассировки
+// WithRoles устанавливает роли пользователя
+func (c *AppContext) WithRoles(roles []string) *AppContext {
+	newCtx := *c
+	newCtx.roles = roles
+	return &newCtx
+}
+
+// Roles возвращает роли пользователя
+func (c *AppContext) Roles() []string {
+	return c.roles
+}
+
+// HasRole проверяет наличие роли у пользователя
+func (c *AppContext) HasRole(role string) bool {
+	for _, r := range c.roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// WithTraceID устанавливает ID трассировки (request ID) и добавляет его в логгер
 func (c *AppContext) WithTraceID(traceID string) *AppContext {
 	newCtx := *c
 	newCtx.traceID = traceID
+	if c.logger != nil {
+		newCtx.logger = c.logger.WithField("request_id", traceID)
+	}
 	return &newCtx
 }
 
@@ -387,131 +847,3 @@ func (c *AppContext) Err() error {
 	contextPath := filepath.Join(g.projectPath, "pkg/context/context.go")
 	return os.WriteFile(contextPath, []byte(content), 0644)
 }
-
-// generateLogger создает файл pkg/logger/logger.go
-func (g *Generator) generateLogger(config *ProjectConfig) error {
-	content := `package logger
-
-import (
-	"fmt"
-	"os"
-
-	"github.com/sirupsen/logrus"
-)
-
-// Logger интерфейс для логгирования
-type Logger interface {
-	Debug(msg string, fields ...interface{})
-	Info(msg string, fields ...interface{})
-	Warn(msg string, fields ...interface{})
-	Error(msg string, fields ...interface{})
-	Fatal(msg string, fields ...interface{})
-	WithField(key string, value interface{}) Logger
-	WithFields(fields map[string]interface{}) Logger
-}
-
-// LogrusLogger реализация Logger на основе logrus
-type LogrusLogger struct {
-	entry *logrus.Entry
-}
-
-// LoggerConfig конфигурация логгера
-type LoggerConfig struct {
-	Level  string
-	Format string
-	Output string
-}
-
-// New создает новый логгер
-func New(config LoggerConfig) Logger {
-	log := logrus.New()
-
-	// Устанавливаем уровень
-	level, err := logrus.ParseLevel(config.Level)
-	if err != nil {
-		level = logrus.InfoLevel
-	}
-	log.SetLevel(level)
-
-	// Устанавливаем формат
-	switch config.Format {
-	case "json":
-		log.SetFormatter(&logrus.JSONFormatter{})
-	default:
-		log.SetFormatter(&logrus.TextFormatter{
-			FullTimestamp: true,
-		})
-	}
-
-	// Устанавливаем вывод
-	switch config.Output {
-	case "stdout":
-		log.SetOutput(os.Stdout)
-	case "stderr":
-		log.SetOutput(os.Stderr)
-	default:
-		log.SetOutput(os.Stdout)
-	}
-
-	return &LogrusLogger{
-		entry: logrus.NewEntry(log),
-	}
-}
-
-// Debug логирует отладочное сообщение
-func (l *LogrusLogger) Debug(msg string, fields ...interface{}) {
-	l.entry.WithFields(l.parseFields(fields...)).Debug(msg)
-}
-
-// Info логирует информационное сообщение
-func (l *LogrusLogger) Info(msg string, fields ...interface{}) {
-	l.entry.WithFields(l.parseFields(fields...)).Info(msg)
-}
-
-// Warn логирует предупреждение
-func (l *LogrusLogger) Warn(msg string, fields ...interface{}) {
-	l.entry.WithFields(l.parseFields(fields...)).Warn(msg)
-}
-
-// Error логирует ошибку
-func (l *LogrusLogger) Error(msg string, fields ...interface{}) {
-	l.entry.WithFields(l.parseFields(fields...)).Error(msg)
-}
-
-// Fatal логирует фатальную ошибку
-func (l *LogrusLogger) Fatal(msg string, fields ...interface{}) {
-	l.entry.WithFields(l.parseFields(fields...)).Fatal(msg)
-}
-
-// WithField добавляет поле к логгеру
-func (l *LogrusLogger) WithField(key string, value interface{}) Logger {
-	return &LogrusLogger{
-		entry: l.entry.WithField(key, value),
-	}
-}
-
-// WithFields добавляет поля к логгеру
-func (l *LogrusLogger) WithFields(fields map[string]interface{}) Logger {
-	return &LogrusLogger{
-		entry: l.entry.WithFields(fields),
-	}
-}
-
-// parseFields парсит поля из slice
-func (l *LogrusLogger) parseFields(fields ...interface{}) logrus.Fields {
-	parsed := make(logrus.Fields)
-	
-	for i := 0; i < len(fields); i += 2 {
-		if i+1 < len(fields) {
-			key := fmt.Sprintf("%v", fields[i])
-			parsed[key] = fields[i+1]
-		}
-	}
-	
-	return parsed
-}
-`
-
-	loggerPath := filepath.Join(g.projectPath, "pkg/logger/logger.go")
-	return os.WriteFile(loggerPath, []byte(content), 0644)
-}
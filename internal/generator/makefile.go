@@ -15,6 +15,10 @@ APP_NAME=%s
 BINARY_NAME=main
 DOCKER_IMAGE=%s
 VERSION=1.0.0
+COMMIT=$(shell git rev-parse --short HEAD 2>/dev/null || echo none)
+BUILD_TIME=$(shell date -u +%%Y-%%m-%%dT%%H:%%M:%%SZ)
+LDFLAGS=-w -s -X main.version=$(VERSION) -X main.commit=$(COMMIT) -X main.buildTime=$(BUILD_TIME)
+CERTS_DIR=certs
 
 # Go переменные
 GOCMD=go
@@ -31,7 +35,7 @@ YELLOW=\\033[0;33m
 BLUE=\\033[0;34m
 NC=\\033[0m # No Color
 
-.PHONY: help build run test clean deps tidy lint docker-build docker-run docker-stop docker-clean swagger dev install
+.PHONY: help build run test clean deps tidy lint docker-build docker-run docker-stop docker-clean swagger dev install certs
 
 # Помощь
 help: ## Показать справку
@@ -61,21 +65,21 @@ tidy: ## Очистить и проверить зависимости
 # Сборка приложения
 build: ## Собрать приложение
 	@echo "$(BLUE)Сборка приложения...$(NC)"
-	$(GOBUILD) -ldflags="-w -s -X main.version=$(VERSION)" -o $(BINARY_NAME) cmd/main.go
+	$(GOBUILD) -ldflags="$(LDFLAGS)" -o $(BINARY_NAME) cmd/main.go
 	@echo "$(GREEN)Сборка завершена: $(BINARY_NAME)$(NC)"
 
 # Сборка для разных платформ
 build-linux: ## Собрать для Linux
 	@echo "$(BLUE)Сборка для Linux...$(NC)"
-	CGO_ENABLED=0 GOOS=linux GOARCH=amd64 $(GOBUILD) -ldflags="-w -s" -o $(BINARY_NAME)-linux cmd/main.go
+	CGO_ENABLED=0 GOOS=linux GOARCH=amd64 $(GOBUILD) -ldflags="$(LDFLAGS)" -o $(BINARY_NAME)-linux cmd/main.go
 
 build-windows: ## Собрать для Windows
 	@echo "$(BLUE)Сборка для Windows...$(NC)"
-	CGO_ENABLED=0 GOOS=windows GOARCH=amd64 $(GOBUILD) -ldflags="-w -s" -o $(BINARY_NAME)-windows.exe cmd/main.go
+	CGO_ENABLED=0 GOOS=windows GOARCH=amd64 $(GOBUILD) -ldflags="$(LDFLAGS)" -o $(BINARY_NAME)-windows.exe cmd/main.go
 
 build-mac: ## Собрать для macOS
 	@echo "$(BLUE)Сборка для macOS...$(NC)"
-	CGO_ENABLED=0 GOOS=darwin GOARCH=amd64 $(GOBUILD) -ldflags="-w -s" -o $(BINARY_NAME)-darwin cmd/main.go
+	CGO_ENABLED=0 GOOS=darwin GOARCH=amd64 $(GOBUILD) -ldflags="$(LDFLAGS)" -o $(BINARY_NAME)-darwin cmd/main.go
 
 build-all: build-linux build-windows build-mac ## Собрать для всех платформ
 
@@ -139,7 +143,7 @@ swagger: ## Генерировать Swagger документацию
 # Docker команды
 docker-build: ## Собрать Docker образ
 	@echo "$(BLUE)Сборка Docker образа...$(NC)"
-	docker build -t $(DOCKER_IMAGE):$(VERSION) -t $(DOCKER_IMAGE):latest .
+	docker build --build-arg VERSION=$(VERSION) --build-arg COMMIT=$(COMMIT) -t $(DOCKER_IMAGE):$(VERSION) -t $(DOCKER_IMAGE):latest .
 	@echo "$(GREEN)Docker образ собран: $(DOCKER_IMAGE):$(VERSION)$(NC)"
 
 docker-run: ## Запустить контейнер
@@ -182,6 +186,20 @@ docker-clean: ## Очистить Docker ресурсы
 	docker system prune -f
 	docker volume prune -f
 
+` + migrateTargets(config) + sqlcTargets(config) + `# TLS сертификаты для локальной разработки
+certs: ## Сгенерировать dev CA и сертификаты для TLS/mTLS
+	@echo "$(BLUE)Генерация сертификатов в $(CERTS_DIR)...$(NC)"
+	@mkdir -p $(CERTS_DIR)
+	openssl req -x509 -newkey rsa:4096 -nodes -days 825 -subj "/CN=$(APP_NAME) Dev CA" -keyout $(CERTS_DIR)/ca-key.pem -out $(CERTS_DIR)/ca.pem
+	openssl req -newkey rsa:2048 -nodes -subj "/CN=localhost" -keyout $(CERTS_DIR)/server-key.pem -out $(CERTS_DIR)/server.csr
+	printf "subjectAltName=DNS:localhost,DNS:$(APP_NAME),IP:127.0.0.1\nextendedKeyUsage=serverAuth\n" > $(CERTS_DIR)/server.ext
+	openssl x509 -req -in $(CERTS_DIR)/server.csr -CA $(CERTS_DIR)/ca.pem -CAkey $(CERTS_DIR)/ca-key.pem -CAcreateserial -days 825 -extfile $(CERTS_DIR)/server.ext -out $(CERTS_DIR)/server.pem
+	openssl req -newkey rsa:2048 -nodes -subj "/CN=$(APP_NAME)-client" -keyout $(CERTS_DIR)/client-key.pem -out $(CERTS_DIR)/client.csr
+	printf "extendedKeyUsage=clientAuth\n" > $(CERTS_DIR)/client.ext
+	openssl x509 -req -in $(CERTS_DIR)/client.csr -CA $(CERTS_DIR)/ca.pem -CAkey $(CERTS_DIR)/ca-key.pem -CAcreateserial -days 825 -extfile $(CERTS_DIR)/client.ext -out $(CERTS_DIR)/client.pem
+	rm -f $(CERTS_DIR)/*.csr $(CERTS_DIR)/*.ext $(CERTS_DIR)/*.srl
+	@echo "$(GREEN)Сертификаты созданы: $(CERTS_DIR)/ca.pem, server.pem, client.pem$(NC)"
+
 # Очистка
 clean: ## Очистить собранные файлы
 	@echo "$(BLUE)Очистка...$(NC)"
@@ -227,3 +245,46 @@ status: ## Показать статус приложения
 	makefilePath := filepath.Join(g.projectPath, "Makefile")
 	return os.WriteFile(makefilePath, []byte(content), 0644)
 }
+
+// migrateTargets возвращает цели миграций: для SQL - файлы migrations/*.sql, для MongoDB - схема из pkg/database/migrate.go
+func migrateTargets(config *ProjectConfig) string {
+	if !hasMigrations(config) {
+		return ""
+	}
+
+	content := `# Миграции БД
+migrate-up: ## Применить миграции
+	@echo "$(BLUE)Применение миграций...$(NC)"
+	$(GOCMD) run cmd/main.go migrate up
+
+migrate-down: ## Откатить последнюю миграцию
+	@echo "$(BLUE)Откат последней миграции...$(NC)"
+	$(GOCMD) run cmd/main.go migrate down
+
+migrate-status: ## Показать статус миграций
+	$(GOCMD) run cmd/main.go migrate status
+
+`
+	if !hasSQLMigrations(config) {
+		return content
+	}
+
+	return content + `migrate-new: ## Создать миграцию: make migrate-new name=add_orders
+	@test -n "$(name)" || (echo "$(RED)Укажите имя: make migrate-new name=add_orders$(NC)"; exit 1)
+	$(GOCMD) run cmd/main.go migrate create $(name)
+
+`
+}
+
+// sqlcTargets возвращает цель генерации кода запросов для --data-access sqlc
+func sqlcTargets(config *ProjectConfig) string {
+	if dataAccess(config) != "sqlc" {
+		return ""
+	}
+	return `# Код запросов internal/repository/queries генерируется из queries/*.sql и схемы migrations/
+sqlc: ## Сгенерировать код запросов sqlc
+	@echo "$(BLUE)Генерация запросов sqlc...$(NC)"
+	$(GOCMD) run github.com/sqlc-dev/sqlc/cmd/sqlc@v1.25.0 generate
+
+`
+}
@@ -0,0 +1,411 @@
+package generator
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// generateMetrics создает пакет pkg/metrics и HTTP middleware метрик
+func (g *Generator) generateMetrics(config *ProjectConfig) error {
+	metricsDir := filepath.Join(g.projectPath, "pkg/metrics")
+	if err := os.MkdirAll(metricsDir, 0755); err != nil {
+		return err
+	}
+
+	files := map[string]string{
+		"metrics.go":      g.generateMetricsRegistry(config),
+		"metrics_test.go": g.generateMetricsTest(),
+	}
+
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(metricsDir, name), []byte(content), 0644); err != nil {
+			return err
+		}
+	}
+
+	return g.generateMetricsMiddleware(config)
+}
+
+// generateMetricsRegistry генерирует реестр Prometheus с RED метриками, runtime и статистикой пула БД
+func (g *Generator) generateMetricsRegistry(config *ProjectConfig) string {
+	hasDB := !strings.Contains(strings.ToLower(config.Database), "без")
+
+	content := `package metrics
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"` + config.ModuleName + `/internal/config"`
+
+	if hasDB {
+		content += `
+	"` + config.ModuleName + `/pkg/database"`
+	}
+
+	content += `
+)
+
+// Registry хранит метрики сервиса в собственном prometheus.Registry
+type Registry struct {
+	registry     *prometheus.Registry
+	httpRequests *prometheus.CounterVec
+	httpErrors   *prometheus.CounterVec
+	httpDuration *prometheus.HistogramVec`
+
+	if config.EnableGRPC {
+		content += `
+	grpcHandled  *prometheus.CounterVec
+	grpcDuration *prometheus.HistogramVec`
+	}
+
+	content += `
+}
+
+// New создает реестр и регистрирует метрики HTTP` + grpcMetricsNote(config) + `, Go runtime и процесса
+func New() *Registry {
+	r := &Registry{
+		registry: prometheus.NewRegistry(),
+		httpRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "http_requests_total",
+			Help: "Количество HTTP запросов по маршруту и статусу",
+		}, []string{"method", "route", "status"}),
+		httpErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "http_request_errors_total",
+			Help: "Количество HTTP запросов, завершившихся ошибкой 5xx",
+		}, []string{"method", "route"}),
+		httpDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "http_request_duration_seconds",
+			Help:    "Длительность обработки HTTP запросов",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"method", "route"}),`
+
+	if config.EnableGRPC {
+		content += `
+		grpcHandled: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "grpc_server_handled_total",
+			Help: "Количество обработанных gRPC вызовов по методу и коду",
+		}, []string{"method", "code"}),
+		grpcDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "grpc_server_handling_seconds",
+			Help:    "Длительность обработки gRPC вызовов",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"method"}),`
+	}
+
+	content += `
+	}
+
+	r.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		r.httpRequests,
+		r.httpErrors,
+		r.httpDuration,`
+
+	if config.EnableGRPC {
+		content += `
+		r.grpcHandled,
+		r.grpcDuration,`
+	}
+
+	content += `
+	)
+
+	return r
+}
+
+// ObserveHTTP записывает результат HTTP запроса. route - шаблон маршрута, а не фактический путь
+func (r *Registry) ObserveHTTP(method, route string, status int, duration time.Duration) {
+	r.httpRequests.WithLabelValues(method, route, strconv.Itoa(status)).Inc()
+	r.httpDuration.WithLabelValues(method, route).Observe(duration.Seconds())
+	if status >= 500 {
+		r.httpErrors.WithLabelValues(method, route).Inc()
+	}
+}
+`
+
+	if config.EnableGRPC {
+		content += `
+// ObserveGRPC записывает результат gRPC вызова
+func (r *Registry) ObserveGRPC(method, code string, duration time.Duration) {
+	r.grpcHandled.WithLabelValues(method, code).Inc()
+	r.grpcDuration.WithLabelValues(method).Observe(duration.Seconds())
+}
+`
+	}
+
+	if hasDB {
+		content += `
+// RegisterDatabase публикует статистику пула соединений из Database.Stats()
+func (r *Registry) RegisterDatabase(db database.Database) {
+	r.registry.MustRegister(newDatabaseCollector(db))
+}
+
+// databaseCollector читает Database.Stats() при каждом опросе /metrics
+type databaseCollector struct {
+	db    database.Database
+	open  *prometheus.Desc
+	inUse *prometheus.Desc
+	idle  *prometheus.Desc`
+		if supportsReplicas(config) {
+			content += `
+	// Статистика реплик для чтения с меткой replica
+	replicaOpen  *prometheus.Desc
+	replicaInUse *prometheus.Desc
+	replicaIdle  *prometheus.Desc`
+		}
+		content += `
+}
+
+func newDatabaseCollector(db database.Database) *databaseCollector {
+	return &databaseCollector{
+		db:    db,
+		open:  prometheus.NewDesc("db_pool_open_connections", "Открытые соединения с БД", nil, nil),
+		inUse: prometheus.NewDesc("db_pool_in_use_connections", "Используемые соединения с БД", nil, nil),
+		idle:  prometheus.NewDesc("db_pool_idle_connections", "Простаивающие соединения с БД", nil, nil),`
+		if supportsReplicas(config) {
+			content += `
+
+		replicaOpen:  prometheus.NewDesc("db_replica_pool_open_connections", "Открытые соединения с репликой БД", []string{"replica"}, nil),
+		replicaInUse: prometheus.NewDesc("db_replica_pool_in_use_connections", "Используемые соединения с репликой БД", []string{"replica"}, nil),
+		replicaIdle:  prometheus.NewDesc("db_replica_pool_idle_connections", "Простаивающие соединения с репликой БД", []string{"replica"}, nil),`
+		}
+		content += `
+	}
+}
+
+// Describe реализует prometheus.Collector
+func (c *databaseCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.open
+	ch <- c.inUse
+	ch <- c.idle`
+		if supportsReplicas(config) {
+			content += `
+	ch <- c.replicaOpen
+	ch <- c.replicaInUse
+	ch <- c.replicaIdle`
+		}
+		content += `
+}
+
+// Collect реализует prometheus.Collector
+func (c *databaseCollector) Collect(ch chan<- prometheus.Metric) {
+	stats := c.db.Stats()
+	ch <- prometheus.MustNewConstMetric(c.open, prometheus.GaugeValue, float64(stats.OpenConnections))
+	ch <- prometheus.MustNewConstMetric(c.inUse, prometheus.GaugeValue, float64(stats.InUseConnections))
+	ch <- prometheus.MustNewConstMetric(c.idle, prometheus.GaugeValue, float64(stats.IdleConnections))`
+		if supportsReplicas(config) {
+			content += `
+
+	for _, replica := range stats.Replicas {
+		ch <- prometheus.MustNewConstMetric(c.replicaOpen, prometheus.GaugeValue, float64(replica.OpenConnections), replica.Name)
+		ch <- prometheus.MustNewConstMetric(c.replicaInUse, prometheus.GaugeValue, float64(replica.InUseConnections), replica.Name)
+		ch <- prometheus.MustNewConstMetric(c.replicaIdle, prometheus.GaugeValue, float64(replica.IdleConnections), replica.Name)
+	}`
+		}
+		content += `
+}
+`
+	}
+
+	content += `
+// Register регистрирует собственные метрики сервиса
+func (r *Registry) Register(cs ...prometheus.Collector) {
+	r.registry.MustRegister(cs...)
+}
+
+// Handler возвращает HTTP handler для экспорта метрик
+func (r *Registry) Handler() http.Handler {
+	return promhttp.HandlerFor(r.registry, promhttp.HandlerOpts{Registry: r.registry})
+}
+
+// NewServer создает отдельный HTTP сервер метрик для admin порта (metrics.port)
+func NewServer(cfg config.MetricsConfig, r *Registry) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle(cfg.Path, r.Handler())
+
+	return &http.Server{
+		Addr:              fmt.Sprintf(":%d", cfg.Port),
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+}
+`
+
+	return content
+}
+
+// grpcMetricsNote дополняет описание New при включенном gRPC
+func grpcMetricsNote(config *ProjectConfig) string {
+	if !config.EnableGRPC {
+		return ""
+	}
+	return ", gRPC"
+}
+
+// generateMetricsTest генерирует тесты пакета metrics
+func (g *Generator) generateMetricsTest() string {
+	return `package metrics
+
+import (
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHandlerExposesHTTPMetrics(t *testing.T) {
+	registry := New()
+	registry.ObserveHTTP("GET", "/api/v1/users/:id", 200, 10*time.Millisecond)
+	registry.ObserveHTTP("GET", "/api/v1/users/:id", 503, time.Second)
+
+	rec := httptest.NewRecorder()
+	registry.Handler().ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	body, _ := io.ReadAll(rec.Body)
+
+	expected := []string{
+		` + "`" + `http_requests_total{method="GET",route="/api/v1/users/:id",status="200"} 1` + "`" + `,
+		` + "`" + `http_requests_total{method="GET",route="/api/v1/users/:id",status="503"} 1` + "`" + `,
+		` + "`" + `http_request_errors_total{method="GET",route="/api/v1/users/:id"} 1` + "`" + `,
+		` + "`" + `http_request_duration_seconds_count{method="GET",route="/api/v1/users/:id"} 2` + "`" + `,
+		"go_goroutines",
+	}
+	for _, line := range expected {
+		if !strings.Contains(string(body), line) {
+			t.Errorf("metrics output does not contain %q", line)
+		}
+	}
+}
+`
+}
+
+// generateMetricsMiddleware создает internal/middleware/metrics.go для выбранного фреймворка
+func (g *Generator) generateMetricsMiddleware(config *ProjectConfig) error {
+	var content string
+
+	switch strings.ToLower(config.Framework) {
+	case "fiber":
+		content = fmt.Sprintf(`package middleware
+
+import (
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"%s/internal/config"
+	"%s/pkg/metrics"
+)
+
+// MetricsMiddleware записывает количество, длительность и ошибки запросов по шаблону маршрута
+func MetricsMiddleware(registry *metrics.Registry, cfg config.MetricsConfig) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if c.Path() == cfg.Path {
+			return c.Next()
+		}
+
+		start := time.Now()
+		err := c.Next()
+
+		status := c.Response().StatusCode()
+		if err != nil {
+			status = fiber.StatusInternalServerError
+			var fiberErr *fiber.Error
+			if errors.As(err, &fiberErr) {
+				status = fiberErr.Code
+			}
+		}
+
+		registry.ObserveHTTP(c.Method(), metricsRoute(c.Route().Path, status), status, time.Since(start))
+		return err
+	}
+}
+`, config.ModuleName, config.ModuleName)
+	case "echo":
+		content = fmt.Sprintf(`package middleware
+
+import (
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"%s/internal/config"
+	"%s/pkg/metrics"
+)
+
+// MetricsMiddleware записывает количество, длительность и ошибки запросов по шаблону маршрута
+func MetricsMiddleware(registry *metrics.Registry, cfg config.MetricsConfig) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if c.Request().URL.Path == cfg.Path {
+				return next(c)
+			}
+
+			start := time.Now()
+			err := next(c)
+			if err != nil {
+				// Фиксируем ответ, чтобы получить итоговый статус
+				c.Error(err)
+			}
+
+			status := c.Response().Status
+			registry.ObserveHTTP(c.Request().Method, metricsRoute(c.Path(), status), status, time.Since(start))
+			return err
+		}
+	}
+}
+`, config.ModuleName, config.ModuleName)
+	default:
+		content = fmt.Sprintf(`package middleware
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"%s/internal/config"
+	"%s/pkg/metrics"
+)
+
+// MetricsMiddleware записывает количество, длительность и ошибки запросов по шаблону маршрута
+func MetricsMiddleware(registry *metrics.Registry, cfg config.MetricsConfig) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.URL.Path == cfg.Path {
+			c.Next()
+			return
+		}
+
+		start := time.Now()
+		c.Next()
+
+		status := c.Writer.Status()
+		registry.ObserveHTTP(c.Request.Method, metricsRoute(c.FullPath(), status), status, time.Since(start))
+	}
+}
+`, config.ModuleName, config.ModuleName)
+	}
+
+	content += `
+// metricsRoute возвращает метку маршрута. Ненайденные маршруты объединяются,
+// чтобы произвольные пути не раздували количество временных рядов
+func metricsRoute(route string, status int) string {
+	if route == "" || status == 404 {
+		return "unmatched"
+	}
+	return route
+}
+`
+
+	metricsPath := filepath.Join(g.projectPath, "internal/middleware/metrics.go")
+	return os.WriteFile(metricsPath, []byte(content), 0644)
+}
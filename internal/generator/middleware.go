@@ -0,0 +1,464 @@
+package generator
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// generateMiddleware создает middleware файлы
+func (g *Generator) generateMiddleware(config *ProjectConfig) error {
+	var content string
+
+	switch strings.ToLower(config.Framework) {
+	case "gin":
+		content = g.generateGinMiddleware(config)
+	case "fiber":
+		content = g.generateFiberMiddleware(config)
+	case "echo":
+		content = g.generateEchoMiddleware(config)
+	default:
+		content = g.generateGinMiddleware(config)
+	}
+
+	middlewarePath := filepath.Join(g.projectPath, "internal/middleware/middleware.go")
+	if err := os.WriteFile(middlewarePath, []byte(content), 0644); err != nil {
+		return err
+	}
+
+	// Создаем общую логику логирования и CORS
+	return g.generateMiddlewareCommon(config)
+}
+
+// generateGinMiddleware генерирует middleware для Gin
+func (g *Generator) generateGinMiddleware(config *ProjectConfig) string {
+	return fmt.Sprintf(`package middleware
+
+import (
+	"net/http"
+	"runtime/debug"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"%s/internal/config"
+	"%s/pkg/logger"
+)
+
+// LoggerMiddleware логирует HTTP запросы через logger.Logger
+func LoggerMiddleware(log logger.Logger, cfg config.LoggerMiddlewareConfig) gin.HandlerFunc {
+	skip := skipPaths(cfg.SkipPaths)
+
+	return func(c *gin.Context) {
+		if _, ok := skip[c.Request.URL.Path]; ok {
+			c.Next()
+			return
+		}
+
+		start := time.Now()
+		c.Next()
+
+		logRequest(log.WithContext(c.Request.Context()), requestInfo{
+			Method:  c.Request.Method,
+			Path:    c.Request.URL.Path,
+			Status:  c.Writer.Status(),
+			Latency: time.Since(start),
+			IP:      c.ClientIP(),
+		})
+	}
+}
+
+// RecoveryMiddleware перехватывает панику и возвращает 500
+func RecoveryMiddleware(log logger.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		defer func() {
+			if r := recover(); r != nil {
+				if r == http.ErrAbortHandler {
+					panic(r)
+				}
+				log.WithContext(c.Request.Context()).Error("Паника при обработке запроса",
+					"panic", r,
+					"path", c.Request.URL.Path,
+					"stack", string(debug.Stack()),
+				)
+				c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+					"error": http.StatusText(http.StatusInternalServerError),
+				})
+			}
+		}()
+		c.Next()
+	}
+}
+
+// CORSMiddleware применяет CORS политику из конфигурации, изменения применяются без перезапуска
+func CORSMiddleware(cors *config.Notifier[config.CORSConfig]) gin.HandlerFunc {
+	policy := watchCORSPolicy(cors)
+
+	return func(c *gin.Context) {
+		origin := c.GetHeader("Origin")
+		if origin == "" {
+			c.Next()
+			return
+		}
+
+		preflight := isPreflight(c.Request)
+		for key, value := range policy.Load().headers(origin, preflight) {
+			c.Header(key, value)
+		}
+
+		if preflight {
+			c.AbortWithStatus(http.StatusNoContent)
+			return
+		}
+
+		c.Next()
+	}
+}
+`, config.ModuleName, config.ModuleName)
+}
+
+// generateFiberMiddleware генерирует middleware для Fiber
+func (g *Generator) generateFiberMiddleware(config *ProjectConfig) string {
+	return fmt.Sprintf(`package middleware
+
+import (
+	"errors"
+	"runtime/debug"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"%s/internal/config"
+	"%s/pkg/logger"
+)
+
+// LoggerMiddleware логирует HTTP запросы через logger.Logger
+func LoggerMiddleware(log logger.Logger, cfg config.LoggerMiddlewareConfig) fiber.Handler {
+	skip := skipPaths(cfg.SkipPaths)
+
+	return func(c *fiber.Ctx) error {
+		if _, ok := skip[c.Path()]; ok {
+			return c.Next()
+		}
+
+		start := time.Now()
+		err := c.Next()
+
+		status := c.Response().StatusCode()
+		if err != nil {
+			status = fiber.StatusInternalServerError
+			var fiberErr *fiber.Error
+			if errors.As(err, &fiberErr) {
+				status = fiberErr.Code
+			}
+		}
+
+		logRequest(log.WithContext(c.UserContext()), requestInfo{
+			Method:  c.Method(),
+			Path:    c.Path(),
+			Status:  status,
+			Latency: time.Since(start),
+			IP:      c.IP(),
+		})
+
+		return err
+	}
+}
+
+// RecoveryMiddleware перехватывает панику и возвращает 500
+func RecoveryMiddleware(log logger.Logger) fiber.Handler {
+	return func(c *fiber.Ctx) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				log.WithContext(c.UserContext()).Error("Паника при обработке запроса",
+					"panic", r,
+					"path", c.Path(),
+					"stack", string(debug.Stack()),
+				)
+				err = fiber.ErrInternalServerError
+			}
+		}()
+		return c.Next()
+	}
+}
+
+// CORSMiddleware применяет CORS политику из конфигурации, изменения применяются без перезапуска
+func CORSMiddleware(cors *config.Notifier[config.CORSConfig]) fiber.Handler {
+	policy := watchCORSPolicy(cors)
+
+	return func(c *fiber.Ctx) error {
+		origin := c.Get(fiber.HeaderOrigin)
+		if origin == "" {
+			return c.Next()
+		}
+
+		preflight := c.Method() == fiber.MethodOptions && c.Get(fiber.HeaderAccessControlRequestMethod) != ""
+		for key, value := range policy.Load().headers(origin, preflight) {
+			c.Set(key, value)
+		}
+
+		if preflight {
+			return c.SendStatus(fiber.StatusNoContent)
+		}
+
+		return c.Next()
+	}
+}
+`, config.ModuleName, config.ModuleName)
+}
+
+// generateEchoMiddleware генерирует middleware для Echo
+func (g *Generator) generateEchoMiddleware(config *ProjectConfig) string {
+	return fmt.Sprintf(`package middleware
+
+import (
+	"net/http"
+	"runtime/debug"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"%s/internal/config"
+	"%s/pkg/logger"
+)
+
+// LoggerMiddleware логирует HTTP запросы через logger.Logger
+func LoggerMiddleware(log logger.Logger, cfg config.LoggerMiddlewareConfig) echo.MiddlewareFunc {
+	skip := skipPaths(cfg.SkipPaths)
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			if _, ok := skip[req.URL.Path]; ok {
+				return next(c)
+			}
+
+			start := time.Now()
+			err := next(c)
+			if err != nil {
+				// Фиксируем ответ, чтобы получить итоговый статус
+				c.Error(err)
+			}
+
+			logRequest(log.WithContext(c.Request().Context()), requestInfo{
+				Method:  req.Method,
+				Path:    req.URL.Path,
+				Status:  c.Response().Status,
+				Latency: time.Since(start),
+				IP:      c.RealIP(),
+			})
+
+			return err
+		}
+	}
+}
+
+// RecoveryMiddleware перехватывает панику и возвращает 500
+func RecoveryMiddleware(log logger.Logger) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) (err error) {
+			defer func() {
+				if r := recover(); r != nil {
+					if r == http.ErrAbortHandler {
+						panic(r)
+					}
+					log.WithContext(c.Request().Context()).Error("Паника при обработке запроса",
+						"panic", r,
+						"path", c.Request().URL.Path,
+						"stack", string(debug.Stack()),
+					)
+					err = echo.NewHTTPError(http.StatusInternalServerError)
+				}
+			}()
+			return next(c)
+		}
+	}
+}
+
+// CORSMiddleware применяет CORS политику из конфигурации, изменения применяются без перезапуска
+func CORSMiddleware(cors *config.Notifier[config.CORSConfig]) echo.MiddlewareFunc {
+	policy := watchCORSPolicy(cors)
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			origin := req.Header.Get(echo.HeaderOrigin)
+			if origin == "" {
+				return next(c)
+			}
+
+			preflight := isPreflight(req)
+			for key, value := range policy.Load().headers(origin, preflight) {
+				c.Response().Header().Set(key, value)
+			}
+
+			if preflight {
+				return c.NoContent(http.StatusNoContent)
+			}
+
+			return next(c)
+		}
+	}
+}
+`, config.ModuleName, config.ModuleName)
+}
+
+// generateMiddlewareCommon создает internal/middleware/common.go
+// с общей для всех фреймворков логикой логирования и CORS
+func (g *Generator) generateMiddlewareCommon(config *ProjectConfig) error {
+	content := fmt.Sprintf(`package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"%s/internal/config"
+	"%s/pkg/logger"
+)
+
+var (
+	defaultCORSMethods = []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"}
+	defaultCORSHeaders = []string{"Origin", "Content-Type", "Accept", "Authorization"}
+)
+
+// requestInfo данные запроса для логирования
+type requestInfo struct {
+	Method  string
+	Path    string
+	Status  int
+	Latency time.Duration
+	IP      string
+}
+
+// logRequest пишет запись о запросе с уровнем, зависящим от статуса ответа
+func logRequest(log logger.Logger, info requestInfo) {
+	fields := []interface{}{
+		"method", info.Method,
+		"path", info.Path,
+		"status", info.Status,
+		"latency_ms", info.Latency.Milliseconds(),
+		"ip", info.IP,
+	}
+
+	switch {
+	case info.Status >= http.StatusInternalServerError:
+		log.Error("HTTP запрос", fields...)
+	case info.Status >= http.StatusBadRequest:
+		log.Warn("HTTP запрос", fields...)
+	default:
+		log.Info("HTTP запрос", fields...)
+	}
+}
+
+// skipPaths строит множество путей, которые не нужно логировать
+func skipPaths(paths []string) map[string]struct{} {
+	skip := make(map[string]struct{}, len(paths))
+	for _, path := range paths {
+		skip[path] = struct{}{}
+	}
+	return skip
+}
+
+// isPreflight проверяет, является ли запрос CORS preflight
+func isPreflight(r *http.Request) bool {
+	return r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != ""
+}
+
+// corsPolicy вычисляет CORS заголовки по конфигурации
+type corsPolicy struct {
+	allowAll         bool
+	origins          map[string]struct{}
+	allowMethods     string
+	allowHeaders     string
+	exposeHeaders    string
+	allowCredentials bool
+	maxAge           string
+}
+
+// newCORSPolicy создает CORS политику
+func newCORSPolicy(cfg config.CORSConfig) *corsPolicy {
+	methods := cfg.AllowMethods
+	if len(methods) == 0 {
+		methods = defaultCORSMethods
+	}
+
+	headers := cfg.AllowHeaders
+	if len(headers) == 0 {
+		headers = defaultCORSHeaders
+	}
+
+	p := &corsPolicy{
+		origins:          make(map[string]struct{}, len(cfg.AllowOrigins)),
+		allowMethods:     strings.Join(methods, ", "),
+		allowHeaders:     strings.Join(headers, ", "),
+		exposeHeaders:    strings.Join(cfg.ExposeHeaders, ", "),
+		allowCredentials: cfg.AllowCredentials,
+	}
+
+	if cfg.MaxAge > 0 {
+		p.maxAge = strconv.Itoa(cfg.MaxAge)
+	}
+
+	for _, origin := range cfg.AllowOrigins {
+		if origin == "*" {
+			p.allowAll = true
+			continue
+		}
+		p.origins[strings.ToLower(origin)] = struct{}{}
+	}
+
+	return p
+}
+
+// watchCORSPolicy возвращает политику, которая пересобирается при перезагрузке секции CORS
+func watchCORSPolicy(cors *config.Notifier[config.CORSConfig]) *atomic.Pointer[corsPolicy] {
+	var policy atomic.Pointer[corsPolicy]
+	policy.Store(newCORSPolicy(cors.Get()))
+	cors.Subscribe(func(cfg config.CORSConfig) {
+		policy.Store(newCORSPolicy(cfg))
+	})
+	return &policy
+}
+
+// headers возвращает CORS заголовки для ответа или nil, если origin не разрешен
+func (p *corsPolicy) headers(origin string, preflight bool) map[string]string {
+	if _, ok := p.origins[strings.ToLower(origin)]; !ok && !p.allowAll {
+		return nil
+	}
+
+	headers := map[string]string{
+		"Vary": "Origin",
+	}
+
+	// С credentials браузер не принимает "*", поэтому отражаем origin
+	if p.allowAll && !p.allowCredentials {
+		headers["Access-Control-Allow-Origin"] = "*"
+	} else {
+		headers["Access-Control-Allow-Origin"] = origin
+	}
+
+	if p.allowCredentials {
+		headers["Access-Control-Allow-Credentials"] = "true"
+	}
+
+	if preflight {
+		headers["Access-Control-Allow-Methods"] = p.allowMethods
+		headers["Access-Control-Allow-Headers"] = p.allowHeaders
+		if p.maxAge != "" {
+			headers["Access-Control-Max-Age"] = p.maxAge
+		}
+	} else if p.exposeHeaders != "" {
+		headers["Access-Control-Expose-Headers"] = p.exposeHeaders
+	}
+
+	return headers
+}
+`, config.ModuleName, config.ModuleName)
+
+	commonPath := filepath.Join(g.projectPath, "internal/middleware/common.go")
+	return os.WriteFile(commonPath, []byte(content), 0644)
+}
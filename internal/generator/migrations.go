@@ -0,0 +1,814 @@
+package generator
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// migrationDialect диалект goose и типы колонок начальных миграций для выбранной БД
+type migrationDialect struct {
+	Goose     string
+	ID        string
+	String    string
+	Text      string
+	Float     string
+	Timestamp string
+	Options   string
+}
+
+// sqlMigrationDialect возвращает диалект миграций или false, если БД не SQL
+func sqlMigrationDialect(config *ProjectConfig) (migrationDialect, bool) {
+	switch strings.ToLower(config.Database) {
+	case "postgresql", "cockroachdb":
+		return migrationDialect{
+			Goose:     "postgres",
+			ID:        "BIGSERIAL PRIMARY KEY",
+			String:    "VARCHAR(255) NOT NULL",
+			Text:      "TEXT NOT NULL DEFAULT ''",
+			Float:     "DOUBLE PRECISION NOT NULL",
+			Timestamp: "TIMESTAMPTZ NOT NULL DEFAULT NOW()",
+		}, true
+	case "mysql":
+		return migrationDialect{
+			Goose:     "mysql",
+			ID:        "BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY",
+			String:    "VARCHAR(255) NOT NULL",
+			Text:      "TEXT NOT NULL",
+			Float:     "DOUBLE NOT NULL",
+			Timestamp: "DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3)",
+			Options:   " ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
+		}, true
+	case "clickhouse":
+		// Таблицы ClickHouse описываются своей миграцией events, типы колонок не используются
+		return migrationDialect{Goose: "clickhouse"}, true
+	case "in-memory", "sqlite":
+		return migrationDialect{
+			Goose:     "sqlite3",
+			ID:        "INTEGER PRIMARY KEY AUTOINCREMENT",
+			String:    "TEXT NOT NULL",
+			Text:      "TEXT NOT NULL DEFAULT ''",
+			Float:     "REAL NOT NULL",
+			Timestamp: "DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP",
+		}, true
+	default:
+		return migrationDialect{}, false
+	}
+}
+
+// hasSQLMigrations проверяет, создаются ли SQL миграции для выбранной БД
+func hasSQLMigrations(config *ProjectConfig) bool {
+	_, ok := sqlMigrationDialect(config)
+	return ok
+}
+
+// hasMigrations проверяет, есть ли у выбранной БД миграции и подкоманда migrate
+func hasMigrations(config *ProjectConfig) bool {
+	return hasSQLMigrations(config) || strings.ToLower(config.Database) == "mongodb"
+}
+
+// generateMigrations создает каталог migrations с начальными миграциями и запуск миграций в pkg/database
+func (g *Generator) generateMigrations(config *ProjectConfig) error {
+	if strings.ToLower(config.Database) == "mongodb" {
+		return g.generateMongoMigrations()
+	}
+
+	dialect, ok := sqlMigrationDialect(config)
+	if !ok {
+		return nil
+	}
+
+	migrationsDir := filepath.Join(g.projectPath, "migrations")
+	if err := os.MkdirAll(migrationsDir, 0755); err != nil {
+		return err
+	}
+
+	files := map[string]string{
+		"migrations/migrations.go":     g.generateMigrationsEmbed(),
+		"pkg/database/migrate.go":      g.generateMigrationRunner(config, dialect),
+		"pkg/database/migrate_test.go": g.generateMigrationRunnerTest(config),
+	}
+
+	if strings.ToLower(config.Database) == "clickhouse" {
+		files["migrations/00001_create_events.sql"] = g.generateEventsMigration()
+	} else {
+		files["migrations/00001_create_users.sql"] = g.generateUsersMigration(dialect)
+		files["migrations/00002_create_products.sql"] = g.generateProductsMigration(dialect)
+	}
+
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(g.projectPath, name), []byte(content), 0644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// generateMigrationsEmbed генерирует пакет, встраивающий SQL файлы в бинарник
+func (g *Generator) generateMigrationsEmbed() string {
+	return `// Package migrations содержит версионные SQL миграции схемы БД.
+// Файлы встраиваются в бинарник, поэтому миграции выполняются без исходников
+package migrations
+
+import "embed"
+
+// Dir каталог миграций относительно корня проекта, в нем migrate create создает новые файлы
+const Dir = "migrations"
+
+// FS встроенные миграции
+//
+//go:embed *.sql
+var FS embed.FS
+`
+}
+
+// generateUsersMigration генерирует миграцию таблицы users для модели User
+func (g *Generator) generateUsersMigration(d migrationDialect) string {
+	return fmt.Sprintf(`-- +goose Up
+CREATE TABLE users (
+    id %s,
+    email %s,
+    name %s,
+    created_at %s,
+    updated_at %s
+)%s;
+
+CREATE UNIQUE INDEX idx_users_email ON users (email);
+
+-- +goose Down
+DROP TABLE users;
+`, d.ID, d.String, d.String, d.Timestamp, d.Timestamp, d.Options)
+}
+
+// generateProductsMigration генерирует миграцию таблицы products для модели Product
+func (g *Generator) generateProductsMigration(d migrationDialect) string {
+	return fmt.Sprintf(`-- +goose Up
+CREATE TABLE products (
+    id %s,
+    name %s,
+    description %s,
+    price %s,
+    created_at %s,
+    updated_at %s
+)%s;
+
+-- +goose Down
+DROP TABLE products;
+`, d.ID, d.String, d.Text, d.Float, d.Timestamp, d.Timestamp, d.Options)
+}
+
+// generateMigrationRunner генерирует выполнение миграций через goose
+func (g *Generator) generateMigrationRunner(config *ProjectConfig, d migrationDialect) string {
+	return `package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/pressly/goose/v3"
+
+	"` + config.ModuleName + `/migrations"
+)
+
+// migrationsDialect диалект SQL для goose
+const migrationsDialect = "` + d.Goose + `"
+
+// sqlProvider реализации Database с доступом к *sql.DB
+type sqlProvider interface {
+	sqlDB() (*sql.DB, error)
+}
+
+// MigrateCommand выполняет команду goose над встроенными миграциями:
+// up, up-by-one, up-to VERSION, down, down-to VERSION, redo, reset, status, version
+func MigrateCommand(ctx context.Context, db Database, command string, args ...string) error {
+	provider, ok := db.(sqlProvider)
+	if !ok {
+		return errors.New("миграции поддерживаются только для SQL баз данных")
+	}
+
+	sqlDB, err := provider.sqlDB()
+	if err != nil {
+		return err
+	}
+
+	switch command {
+	case "create", "fix":
+		return fmt.Errorf("команда %s меняет файлы, используйте CreateMigration", command)
+	}
+
+	goose.SetBaseFS(migrations.FS)
+	if err := goose.SetDialect(migrationsDialect); err != nil {
+		return err
+	}
+
+	if err := goose.RunContext(ctx, command, sqlDB, ".", args...); err != nil {
+		return fmt.Errorf("ошибка миграции %s: %w", command, err)
+	}
+	return nil
+}
+
+// CreateMigration создает пустую SQL миграцию со следующим номером в каталоге migrations.
+// Выполняется из корня проекта, новая миграция попадет в бинарник при следующей сборке
+func CreateMigration(name string) error {
+	goose.SetBaseFS(nil)
+	goose.SetSequential(true)
+	return goose.Create(nil, migrations.Dir, name, "sql")
+}
+`
+}
+
+// generateMigrationRunnerTest генерирует тесты миграций
+func (g *Generator) generateMigrationRunnerTest(config *ProjectConfig) string {
+	sqlite := isSQLite(config)
+
+	content := `package database
+
+import (`
+
+	if sqlite {
+		content += `
+	"context"`
+	}
+
+	content += `
+	"io/fs"` + sqliteTestImport(config) + `
+	"strings"
+	"testing"
+`
+
+	if sqlite {
+		content += `
+	"` + config.ModuleName + `/internal/config"`
+	}
+
+	content += `
+	"` + config.ModuleName + `/migrations"
+)
+
+func TestMigrationsEmbedded(t *testing.T) {
+	files, err := fs.Glob(migrations.FS, "*.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatal("no migrations embedded")
+	}
+
+	for _, file := range files {
+		data, err := fs.ReadFile(migrations.FS, file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, marker := range []string{"-- +goose Up", "-- +goose Down"} {
+			if !strings.Contains(string(data), marker) {
+				t.Errorf("%s: missing %q", file, marker)
+			}
+		}
+	}
+}
+`
+
+	if sqlite {
+		content += `
+func TestMigrateUpAndDown(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Database = ` + sqliteTestDatabase(config) + `
+
+	db, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.Migrate(); err != nil {
+		t.Fatalf("Migrate failed: %v", err)
+	}
+
+	sqlDB, err := db.(sqlProvider).sqlDB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sqlDB.Exec("INSERT INTO users (email, name) VALUES ('a@example.com', 'A')"); err != nil {
+		t.Fatalf("users table not created: %v", err)
+	}
+	if _, err := sqlDB.Exec("INSERT INTO users (email, name) VALUES ('a@example.com', 'B')"); err == nil {
+		t.Error("expected unique violation for users.email")
+	}
+
+	if err := MigrateCommand(context.Background(), db, "reset"); err != nil {
+		t.Fatalf("reset failed: %v", err)
+	}
+	if _, err := sqlDB.Exec("SELECT 1 FROM users"); err == nil {
+		t.Error("users table must be dropped after reset")
+	}
+}
+`
+	}
+
+	return content
+}
+
+// migrateCommand возвращает функцию runMigrate для cmd/main.go
+func migrateCommand(config *ProjectConfig) string {
+	if !hasSQLMigrations(config) {
+		return `
+// runMigrate выполняет подкоманду migrate: ./main migrate up|down|status
+func runMigrate(configPath string, args []string) error {
+	if len(args) == 0 {
+		return errors.New("укажите команду: up, down или status")
+	}
+` + migrateConnect
+	}
+
+	return `
+// runMigrate выполняет подкоманду migrate:
+// ./main migrate up|down|status|version|redo|reset|up-to VERSION|down-to VERSION|create NAME
+func runMigrate(configPath string, args []string) error {
+	if len(args) == 0 {
+		return errors.New("укажите команду: up, down, status, version, redo, reset или create NAME")
+	}
+
+	if args[0] == "create" {
+		if len(args) != 2 {
+			return errors.New("использование: migrate create NAME")
+		}
+		return database.CreateMigration(args[1])
+	}
+` + migrateConnect
+}
+
+// migrateConnect подключается к БД и выполняет команду миграций
+const migrateConnect = `
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return fmt.Errorf("ошибка загрузки конфигурации: %w", err)
+	}
+
+	db, err := database.New(cfg)
+	if err != nil {
+		return fmt.Errorf("ошибка подключения к БД: %w", err)
+	}
+	defer db.Close()
+
+	return database.MigrateCommand(context.Background(), db, args[0], args[1:]...)
+}
+`
+
+// generateMongoMigrations создает pkg/database/migrate.go для MongoDB: индексы, валидаторы и журнал миграций
+func (g *Generator) generateMongoMigrations() error {
+	files := map[string]string{
+		"migrate.go":      g.generateMongoMigrationRunner(),
+		"migrate_test.go": g.generateMongoMigrationRunnerTest(),
+	}
+
+	for name, content := range files {
+		path := filepath.Join(g.projectPath, "pkg/database", name)
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// generateMongoMigrationRunner генерирует декларативную схему коллекций и версионные миграции MongoDB
+func (g *Generator) generateMongoMigrationRunner() string {
+	return `package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MigrationsCollection журнал примененных версионных миграций
+const MigrationsCollection = "schema_migrations"
+
+// Collection декларативное описание коллекции. Migrate приводит БД к описанию при каждом запуске,
+// повторное применение ничего не меняет. Индексы, которых нет в описании, не удаляются
+type Collection struct {
+	Name    string
+	Indexes []Index
+	// Validator $jsonSchema для документов, nil - без проверки.
+	// Применяется с validationLevel moderate: уже сохраненные невалидные документы можно обновлять
+	Validator bson.M
+}
+
+// Index описание индекса. По Name индекс сравнивается с существующим и пересоздается при изменении
+type Index struct {
+	Name   string
+	Keys   bson.D
+	Unique bool
+	// TTL удаляет документ через TTL после времени в индексируемом поле, 0 - обычный индекс
+	TTL time.Duration
+}
+
+// Collections схема коллекций сервиса
+var Collections = []Collection{
+	{
+		Name: "users",
+		Indexes: []Index{
+			{Name: "email_unique", Keys: bson.D{{Key: "email", Value: 1}}, Unique: true},
+			{Name: "created_at_desc", Keys: bson.D{{Key: "created_at", Value: -1}}},
+		},
+		Validator: bson.M{
+			"bsonType": "object",
+			"required": bson.A{"email", "name"},
+			"properties": bson.M{
+				"email": bson.M{"bsonType": "string", "pattern": "^[^@\\s]+@[^@\\s]+$"},
+				"name":  bson.M{"bsonType": "string", "minLength": 1},
+			},
+		},
+	},
+	{
+		Name: "products",
+		Indexes: []Index{
+			{Name: "name_price", Keys: bson.D{{Key: "name", Value: 1}, {Key: "price", Value: 1}}},
+		},
+		Validator: bson.M{
+			"bsonType": "object",
+			"required": bson.A{"name", "price"},
+			"properties": bson.M{
+				"name":  bson.M{"bsonType": "string", "minLength": 1},
+				"price": bson.M{"bsonType": bson.A{"double", "int", "long", "decimal"}, "minimum": 0},
+			},
+		},
+	},
+	{
+		// Сессии удаляются MongoDB через сутки после created_at
+		Name: "sessions",
+		Indexes: []Index{
+			{Name: "user_id", Keys: bson.D{{Key: "user_id", Value: 1}}},
+			{Name: "created_at_ttl", Keys: bson.D{{Key: "created_at", Value: 1}}, TTL: 24 * time.Hour},
+		},
+	},
+}
+
+// Migration версионная миграция данных, выполняется один раз и записывается в MigrationsCollection
+type Migration struct {
+	Version     int
+	Description string
+	Up          func(ctx context.Context, db *mongo.Database) error
+	// Down откатывает Up, nil - откат не поддерживается
+	Down func(ctx context.Context, db *mongo.Database) error
+}
+
+// Migrations версионные миграции по возрастанию Version. Новые добавляйте в конец:
+//
+//	{
+//		Version:     1,
+//		Description: "статус пользователей по умолчанию",
+//		Up: func(ctx context.Context, db *mongo.Database) error {
+//			_, err := db.Collection("users").UpdateMany(ctx,
+//				bson.M{"status": bson.M{"$exists": false}}, bson.M{"$set": bson.M{"status": "active"}})
+//			return err
+//		},
+//		Down: func(ctx context.Context, db *mongo.Database) error {
+//			_, err := db.Collection("users").UpdateMany(ctx, bson.M{}, bson.M{"$unset": bson.M{"status": ""}})
+//			return err
+//		},
+//	},
+var Migrations = []Migration{}
+
+// migrationRecord запись журнала миграций
+type migrationRecord struct {
+	Version     int       ` + "`" + `bson:"_id"` + "`" + `
+	Description string    ` + "`" + `bson:"description"` + "`" + `
+	AppliedAt   time.Time ` + "`" + `bson:"applied_at"` + "`" + `
+}
+
+// MigrateCommand выполняет команду миграций:
+// up - схема Collections и невыполненные Migrations, down - откат последней миграции, status - журнал
+func MigrateCommand(ctx context.Context, db Database, command string, args ...string) error {
+	mongoDB, ok := db.(*MongoDatabase)
+	if !ok {
+		return errors.New("ожидается подключение MongoDB")
+	}
+	if len(args) > 0 {
+		return fmt.Errorf("команда %s не принимает аргументы", command)
+	}
+
+	database := mongoDB.Database()
+	switch command {
+	case "up":
+		if err := ApplySchema(ctx, database, Collections); err != nil {
+			return err
+		}
+		return migrateUp(ctx, database, Migrations)
+	case "down":
+		return migrateDown(ctx, database, Migrations)
+	case "status":
+		return migrationStatus(ctx, database, Migrations)
+	default:
+		return fmt.Errorf("неизвестная команда %q, доступны: up, down, status", command)
+	}
+}
+
+// ApplySchema создает недостающие коллекции, обновляет валидаторы и индексы
+func ApplySchema(ctx context.Context, db *mongo.Database, collections []Collection) error {
+	names, err := db.ListCollectionNames(ctx, bson.M{})
+	if err != nil {
+		return fmt.Errorf("ошибка получения списка коллекций: %w", err)
+	}
+
+	existing := make(map[string]bool, len(names))
+	for _, name := range names {
+		existing[name] = true
+	}
+
+	for _, collection := range collections {
+		if err := applyValidator(ctx, db, collection, existing[collection.Name]); err != nil {
+			return fmt.Errorf("коллекция %s: %w", collection.Name, err)
+		}
+		if err := applyIndexes(ctx, db.Collection(collection.Name), collection.Indexes); err != nil {
+			return fmt.Errorf("коллекция %s: %w", collection.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// applyValidator создает коллекцию с валидатором или обновляет валидатор существующей через collMod
+func applyValidator(ctx context.Context, db *mongo.Database, collection Collection, exists bool) error {
+	if !exists {
+		opts := options.CreateCollection()
+		if collection.Validator != nil {
+			opts.SetValidator(bson.M{"$jsonSchema": collection.Validator}).SetValidationLevel("moderate")
+		}
+		return db.CreateCollection(ctx, collection.Name, opts)
+	}
+
+	if collection.Validator == nil {
+		return nil
+	}
+
+	return db.RunCommand(ctx, bson.D{
+		{Key: "collMod", Value: collection.Name},
+		{Key: "validator", Value: bson.M{"$jsonSchema": collection.Validator}},
+		{Key: "validationLevel", Value: "moderate"},
+	}).Err()
+}
+
+// indexSpec индекс в ответе listIndexes
+type indexSpec struct {
+	Name               string ` + "`" + `bson:"name"` + "`" + `
+	Key                bson.D ` + "`" + `bson:"key"` + "`" + `
+	Unique             bool   ` + "`" + `bson:"unique,omitempty"` + "`" + `
+	ExpireAfterSeconds *int64 ` + "`" + `bson:"expireAfterSeconds,omitempty"` + "`" + `
+}
+
+// applyIndexes создает недостающие индексы и пересоздает измененные
+func applyIndexes(ctx context.Context, collection *mongo.Collection, indexes []Index) error {
+	cursor, err := collection.Indexes().List(ctx)
+	if err != nil {
+		return err
+	}
+
+	var specs []indexSpec
+	if err := cursor.All(ctx, &specs); err != nil {
+		return err
+	}
+
+	current := make(map[string]indexSpec, len(specs))
+	for _, spec := range specs {
+		current[spec.Name] = spec
+	}
+
+	for _, index := range indexes {
+		if spec, ok := current[index.Name]; ok {
+			if indexMatches(spec, index) {
+				continue
+			}
+			if _, err := collection.Indexes().DropOne(ctx, index.Name); err != nil {
+				return fmt.Errorf("ошибка удаления индекса %s: %w", index.Name, err)
+			}
+		}
+		if _, err := collection.Indexes().CreateOne(ctx, index.model()); err != nil {
+			return fmt.Errorf("ошибка создания индекса %s: %w", index.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// model возвращает описание индекса для драйвера
+func (i Index) model() mongo.IndexModel {
+	opts := options.Index().SetName(i.Name)
+	if i.Unique {
+		opts.SetUnique(true)
+	}
+	if i.TTL > 0 {
+		opts.SetExpireAfterSeconds(int32(i.TTL / time.Second))
+	}
+	return mongo.IndexModel{Keys: i.Keys, Options: opts}
+}
+
+// indexMatches сравнивает существующий индекс с описанием: ключи с порядком, unique и TTL
+func indexMatches(spec indexSpec, index Index) bool {
+	if len(spec.Key) != len(index.Keys) || spec.Unique != index.Unique {
+		return false
+	}
+	for i, key := range index.Keys {
+		if spec.Key[i].Key != key.Key || fmt.Sprint(spec.Key[i].Value) != fmt.Sprint(key.Value) {
+			return false
+		}
+	}
+
+	var ttl int64
+	if spec.ExpireAfterSeconds != nil {
+		ttl = *spec.ExpireAfterSeconds
+	}
+	return ttl == int64(index.TTL/time.Second)
+}
+
+// checkMigrations проверяет, что версии миграций возрастают и у каждой есть Up
+func checkMigrations(migrations []Migration) error {
+	last := 0
+	for _, migration := range migrations {
+		if migration.Version <= last {
+			return fmt.Errorf("версия миграции %d должна быть больше %d", migration.Version, last)
+		}
+		if migration.Up == nil {
+			return fmt.Errorf("у миграции %d нет Up", migration.Version)
+		}
+		last = migration.Version
+	}
+	return nil
+}
+
+// appliedMigrations возвращает записи журнала по версиям
+func appliedMigrations(ctx context.Context, db *mongo.Database) (map[int]migrationRecord, error) {
+	cursor, err := db.Collection(MigrationsCollection).Find(ctx, bson.M{})
+	if err != nil {
+		return nil, fmt.Errorf("ошибка чтения журнала миграций: %w", err)
+	}
+
+	var records []migrationRecord
+	if err := cursor.All(ctx, &records); err != nil {
+		return nil, fmt.Errorf("ошибка чтения журнала миграций: %w", err)
+	}
+
+	applied := make(map[int]migrationRecord, len(records))
+	for _, record := range records {
+		applied[record.Version] = record
+	}
+	return applied, nil
+}
+
+// migrateUp выполняет невыполненные миграции по порядку и записывает их в журнал
+func migrateUp(ctx context.Context, db *mongo.Database, migrations []Migration) error {
+	if err := checkMigrations(migrations); err != nil {
+		return err
+	}
+
+	applied, err := appliedMigrations(ctx, db)
+	if err != nil {
+		return err
+	}
+
+	for _, migration := range migrations {
+		if _, ok := applied[migration.Version]; ok {
+			continue
+		}
+
+		if err := migration.Up(ctx, db); err != nil {
+			return fmt.Errorf("миграция %d (%s): %w", migration.Version, migration.Description, err)
+		}
+
+		record := migrationRecord{Version: migration.Version, Description: migration.Description, AppliedAt: time.Now().UTC()}
+		if _, err := db.Collection(MigrationsCollection).InsertOne(ctx, record); err != nil {
+			return fmt.Errorf("ошибка записи миграции %d в журнал: %w", migration.Version, err)
+		}
+		log.Printf("OK   %d %s", migration.Version, migration.Description)
+	}
+
+	return nil
+}
+
+// migrateDown откатывает последнюю примененную миграцию
+func migrateDown(ctx context.Context, db *mongo.Database, migrations []Migration) error {
+	applied, err := appliedMigrations(ctx, db)
+	if err != nil {
+		return err
+	}
+
+	for i := len(migrations) - 1; i >= 0; i-- {
+		migration := migrations[i]
+		if _, ok := applied[migration.Version]; !ok {
+			continue
+		}
+		if migration.Down == nil {
+			return fmt.Errorf("миграция %d (%s) не поддерживает откат", migration.Version, migration.Description)
+		}
+
+		if err := migration.Down(ctx, db); err != nil {
+			return fmt.Errorf("откат миграции %d (%s): %w", migration.Version, migration.Description, err)
+		}
+		if _, err := db.Collection(MigrationsCollection).DeleteOne(ctx, bson.M{"_id": migration.Version}); err != nil {
+			return fmt.Errorf("ошибка удаления миграции %d из журнала: %w", migration.Version, err)
+		}
+		log.Printf("OK   откат %d %s", migration.Version, migration.Description)
+		return nil
+	}
+
+	return errors.New("нет примененных миграций")
+}
+
+// migrationStatus выводит примененные и ожидающие миграции
+func migrationStatus(ctx context.Context, db *mongo.Database, migrations []Migration) error {
+	applied, err := appliedMigrations(ctx, db)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("    Applied At                  Migration")
+	log.Printf("    =======================================")
+	for _, migration := range migrations {
+		status := "Pending"
+		if record, ok := applied[migration.Version]; ok {
+			status = record.AppliedAt.Format(time.ANSIC)
+		}
+		log.Printf("    %-24s -- %d %s", status, migration.Version, migration.Description)
+	}
+
+	return nil
+}
+`
+}
+
+// generateMongoMigrationRunnerTest генерирует тесты описания схемы MongoDB без подключения к серверу
+func (g *Generator) generateMongoMigrationRunnerTest() string {
+	return `package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestCollectionsDeclaration(t *testing.T) {
+	for _, collection := range Collections {
+		names := make(map[string]bool)
+		for _, index := range collection.Indexes {
+			if index.Name == "" || len(index.Keys) == 0 {
+				t.Errorf("%s: index must have name and keys: %+v", collection.Name, index)
+			}
+			if names[index.Name] {
+				t.Errorf("%s: duplicate index name %s", collection.Name, index.Name)
+			}
+			names[index.Name] = true
+		}
+	}
+
+	if err := checkMigrations(Migrations); err != nil {
+		t.Errorf("invalid Migrations: %v", err)
+	}
+}
+
+func TestCheckMigrationsOrder(t *testing.T) {
+	up := func(context.Context, *mongo.Database) error { return nil }
+	err := checkMigrations([]Migration{{Version: 2, Up: up}, {Version: 1, Up: up}})
+	if err == nil {
+		t.Error("expected error for unordered versions")
+	}
+}
+
+func TestIndexMatches(t *testing.T) {
+	ttl := int64(86400)
+	spec := indexSpec{
+		Name:               "created_at_ttl",
+		Key:                bson.D{{Key: "created_at", Value: int32(1)}},
+		ExpireAfterSeconds: &ttl,
+	}
+
+	index := Index{Name: "created_at_ttl", Keys: bson.D{{Key: "created_at", Value: 1}}, TTL: 24 * time.Hour}
+	if !indexMatches(spec, index) {
+		t.Error("expected existing index to match declaration")
+	}
+
+	index.TTL = time.Hour
+	if indexMatches(spec, index) {
+		t.Error("changed TTL must not match")
+	}
+
+	compound := Index{Name: "name_price", Keys: bson.D{{Key: "price", Value: 1}, {Key: "name", Value: 1}}}
+	existing := indexSpec{Name: "name_price", Key: bson.D{{Key: "name", Value: int32(1)}, {Key: "price", Value: int32(1)}}}
+	if indexMatches(existing, compound) {
+		t.Error("key order must be compared")
+	}
+}
+`
+}
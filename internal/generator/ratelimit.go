@@ -0,0 +1,636 @@
+package generator
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// generateRateLimit создает пакет pkg/ratelimit и middleware ограничения запросов
+func (g *Generator) generateRateLimit(config *ProjectConfig) error {
+	rateLimitDir := filepath.Join(g.projectPath, "pkg/ratelimit")
+	if err := os.MkdirAll(rateLimitDir, 0755); err != nil {
+		return err
+	}
+
+	files := map[string]string{
+		"ratelimit.go":   g.generateRateLimitCore(config),
+		"memory.go":      g.generateRateLimitMemory(),
+		"redis.go":       g.generateRateLimitRedis(config),
+		"memory_test.go": g.generateRateLimitTest(),
+	}
+
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(rateLimitDir, name), []byte(content), 0644); err != nil {
+			return err
+		}
+	}
+
+	return g.generateRateLimitMiddleware(config)
+}
+
+// generateRateLimitCore генерирует интерфейс Limiter и правила ограничения
+func (g *Generator) generateRateLimitCore(config *ProjectConfig) string {
+	return fmt.Sprintf(`package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"%s/internal/config"
+)
+
+// Rule описывает token bucket: Requests запросов за Window с запасом Burst
+type Rule struct {
+	Requests int
+	Window   time.Duration
+	Burst    int
+}
+
+// Capacity возвращает емкость bucket
+func (r Rule) Capacity() int {
+	if r.Burst > 0 {
+		return r.Burst
+	}
+	return r.Requests
+}
+
+// rate возвращает скорость пополнения в токенах за секунду
+func (r Rule) rate() float64 {
+	return float64(r.Requests) / r.Window.Seconds()
+}
+
+// Policy возвращает значение заголовка RateLimit-Policy
+func (r Rule) Policy() string {
+	return fmt.Sprintf("%%d;w=%%d", r.Requests, int(r.Window.Seconds()))
+}
+
+// Result результат проверки лимита
+type Result struct {
+	Allowed    bool
+	Limit      int
+	Remaining  int
+	ResetAfter time.Duration
+	RetryAfter time.Duration
+}
+
+// Headers возвращает заголовки RateLimit-* и Retry-After для ответа
+func (r Result) Headers(rule Rule) map[string]string {
+	headers := map[string]string{
+		"RateLimit-Limit":     strconv.Itoa(r.Limit),
+		"RateLimit-Remaining": strconv.Itoa(r.Remaining),
+		"RateLimit-Reset":     strconv.Itoa(seconds(r.ResetAfter)),
+		"RateLimit-Policy":    rule.Policy(),
+	}
+	if !r.Allowed {
+		headers["Retry-After"] = strconv.Itoa(seconds(r.RetryAfter))
+	}
+	return headers
+}
+
+// Limiter проверяет и списывает токены для ключа клиента
+type Limiter interface {
+	Allow(ctx context.Context, key string, rule Rule) (Result, error)
+	Close() error
+}
+
+// New создает Limiter для выбранного backend
+func New(cfg config.RateLimitConfig) (Limiter, error) {
+	switch cfg.Backend {
+	case "", "memory":
+		return NewMemoryLimiter(), nil
+	case "redis":
+		return NewRedisLimiter(cfg.Redis)
+	default:
+		return nil, fmt.Errorf("неизвестный backend rate limiting: %%s", cfg.Backend)
+	}
+}
+
+// RuleFor возвращает правило для группы маршрутов или правило по умолчанию
+func RuleFor(cfg config.RateLimitConfig, group string) Rule {
+	rule, ok := cfg.Groups[group]
+	if !ok {
+		rule = cfg.Default
+	}
+
+	result := Rule{
+		Requests: rule.Requests,
+		Window:   time.Duration(rule.Window) * time.Second,
+		Burst:    rule.Burst,
+	}
+	if result.Requests <= 0 {
+		result.Requests = 100
+	}
+	if result.Window <= 0 {
+		result.Window = time.Minute
+	}
+	return result
+}
+
+// newResult вычисляет результат по оставшимся токенам
+func newResult(rule Rule, allowed bool, tokens float64) Result {
+	capacity := rule.Capacity()
+	rate := rule.rate()
+
+	result := Result{
+		Allowed:    allowed,
+		Limit:      capacity,
+		Remaining:  int(math.Floor(tokens)),
+		ResetAfter: toDuration((float64(capacity) - tokens) / rate),
+	}
+	if !allowed {
+		result.RetryAfter = toDuration((1 - tokens) / rate)
+	}
+	return result
+}
+
+// toDuration переводит секунды в time.Duration
+func toDuration(secs float64) time.Duration {
+	if secs <= 0 {
+		return 0
+	}
+	return time.Duration(secs * float64(time.Second))
+}
+
+// seconds округляет длительность вверх до целых секунд
+func seconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
+`, config.ModuleName)
+}
+
+// generateRateLimitMemory генерирует шардированный in-memory limiter
+func (g *Generator) generateRateLimitMemory() string {
+	return `package ratelimit
+
+import (
+	"context"
+	"hash/fnv"
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	shardCount      = 32
+	cleanupInterval = time.Minute
+)
+
+// bucket состояние token bucket одного ключа
+type bucket struct {
+	tokens float64
+	last   time.Time
+	full   time.Time
+}
+
+// shard часть ключей со своей блокировкой
+type shard struct {
+	mu      sync.Mutex
+	buckets map[string]*bucket
+}
+
+// MemoryLimiter хранит buckets в памяти процесса, ключи распределены по шардам
+type MemoryLimiter struct {
+	shards [shardCount]*shard
+	now    func() time.Time
+	stop   chan struct{}
+	once   sync.Once
+}
+
+// NewMemoryLimiter создает in-memory limiter и запускает очистку неактивных ключей
+func NewMemoryLimiter() *MemoryLimiter {
+	l := &MemoryLimiter{
+		now:  time.Now,
+		stop: make(chan struct{}),
+	}
+	for i := range l.shards {
+		l.shards[i] = &shard{buckets: make(map[string]*bucket)}
+	}
+
+	go l.cleanup()
+	return l
+}
+
+// Allow списывает токен для ключа, если он доступен
+func (l *MemoryLimiter) Allow(_ context.Context, key string, rule Rule) (Result, error) {
+	s := l.shard(key)
+	now := l.now()
+	capacity := float64(rule.Capacity())
+	rate := rule.rate()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{tokens: capacity, last: now}
+		s.buckets[key] = b
+	}
+
+	elapsed := now.Sub(b.last).Seconds()
+	if elapsed > 0 {
+		b.tokens = math.Min(capacity, b.tokens+elapsed*rate)
+		b.last = now
+	}
+
+	allowed := b.tokens >= 1
+	if allowed {
+		b.tokens--
+	}
+	b.full = now.Add(toDuration((capacity - b.tokens) / rate))
+
+	return newResult(rule, allowed, b.tokens), nil
+}
+
+// Close останавливает очистку
+func (l *MemoryLimiter) Close() error {
+	l.once.Do(func() { close(l.stop) })
+	return nil
+}
+
+// shard возвращает шард для ключа
+func (l *MemoryLimiter) shard(key string) *shard {
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	return l.shards[h.Sum32()%shardCount]
+}
+
+// cleanup удаляет buckets, которые уже полностью восстановились
+func (l *MemoryLimiter) cleanup() {
+	ticker := time.NewTicker(cleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-l.stop:
+			return
+		case <-ticker.C:
+			now := l.now()
+			for _, s := range l.shards {
+				s.mu.Lock()
+				for key, b := range s.buckets {
+					if !now.Before(b.full) {
+						delete(s.buckets, key)
+					}
+				}
+				s.mu.Unlock()
+			}
+		}
+	}
+}
+`
+}
+
+// generateRateLimitRedis генерирует распределенный limiter на Redis
+func (g *Generator) generateRateLimitRedis(config *ProjectConfig) string {
+	return fmt.Sprintf(`package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/redis/go-redis/v9"
+
+	"%s/internal/config"
+)
+
+// tokenBucketScript атомарно пополняет и списывает токены, время берется из Redis
+var tokenBucketScript = redis.NewScript(%s
+local capacity = tonumber(ARGV[1])
+local rate = tonumber(ARGV[2])
+local clock = redis.call("TIME")
+local now = tonumber(clock[1]) * 1000 + math.floor(tonumber(clock[2]) / 1000)
+
+local state = redis.call("HMGET", KEYS[1], "tokens", "ts")
+local tokens = tonumber(state[1])
+local ts = tonumber(state[2])
+if tokens == nil then
+	tokens = capacity
+	ts = now
+end
+
+tokens = math.min(capacity, tokens + math.max(0, now - ts) * rate)
+
+local allowed = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+end
+
+redis.call("HSET", KEYS[1], "tokens", tostring(tokens), "ts", now)
+redis.call("PEXPIRE", KEYS[1], math.ceil((capacity - tokens) / rate) + 1000)
+
+return {allowed, tostring(tokens)}
+%s)
+
+// RedisLimiter хранит buckets в Redis, лимит общий для всех экземпляров сервиса
+type RedisLimiter struct {
+	client *redis.Client
+	prefix string
+}
+
+// NewRedisLimiter подключается к Redis
+func NewRedisLimiter(cfg config.RateLimitRedisConfig) (*RedisLimiter, error) {
+	client := redis.NewClient(&redis.Options{
+		Addr:     cfg.Addr,
+		Password: cfg.Password,
+		DB:       cfg.DB,
+	})
+
+	if err := client.Ping(context.Background()).Err(); err != nil {
+		client.Close()
+		return nil, fmt.Errorf("ошибка подключения к Redis: %%w", err)
+	}
+
+	prefix := cfg.Prefix
+	if prefix == "" {
+		prefix = "ratelimit"
+	}
+
+	return &RedisLimiter{client: client, prefix: prefix}, nil
+}
+
+// Allow списывает токен для ключа, если он доступен
+func (l *RedisLimiter) Allow(ctx context.Context, key string, rule Rule) (Result, error) {
+	// Скорость пополнения передается в токенах за миллисекунду
+	rate := rule.rate() / 1000
+
+	values, err := tokenBucketScript.Run(ctx, l.client, []string{l.prefix + ":" + key}, rule.Capacity(), rate).Slice()
+	if err != nil {
+		return Result{}, fmt.Errorf("ошибка rate limiting в Redis: %%w", err)
+	}
+	if len(values) != 2 {
+		return Result{}, fmt.Errorf("неожиданный ответ Redis: %%v", values)
+	}
+
+	allowed, _ := values[0].(int64)
+	raw, _ := values[1].(string)
+	tokens, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return Result{}, fmt.Errorf("неожиданный ответ Redis: %%w", err)
+	}
+
+	return newResult(rule, allowed == 1, tokens), nil
+}
+
+// Client возвращает клиент Redis, например для проверки состояния
+func (l *RedisLimiter) Client() *redis.Client {
+	return l.client
+}
+
+// Close закрывает соединение с Redis
+func (l *RedisLimiter) Close() error {
+	return l.client.Close()
+}
+`, config.ModuleName, "`", "`")
+}
+
+// generateRateLimitTest генерирует тесты in-memory limiter
+func (g *Generator) generateRateLimitTest() string {
+	return `package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestMemoryLimiterExhaustsAndRefills(t *testing.T) {
+	limiter := NewMemoryLimiter()
+	defer limiter.Close()
+
+	now := time.Now()
+	limiter.now = func() time.Time { return now }
+
+	rule := Rule{Requests: 2, Window: time.Second}
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		res, err := limiter.Allow(ctx, "client", rule)
+		if err != nil || !res.Allowed {
+			t.Fatalf("request %d should be allowed: %+v, %v", i, res, err)
+		}
+	}
+
+	res, _ := limiter.Allow(ctx, "client", rule)
+	if res.Allowed {
+		t.Fatal("third request should be limited")
+	}
+	if res.RetryAfter <= 0 || res.RetryAfter > time.Second {
+		t.Errorf("unexpected RetryAfter: %v", res.RetryAfter)
+	}
+
+	now = now.Add(500 * time.Millisecond)
+	if res, _ := limiter.Allow(ctx, "client", rule); !res.Allowed {
+		t.Error("request should be allowed after refill")
+	}
+}
+
+func TestMemoryLimiterSeparatesKeys(t *testing.T) {
+	limiter := NewMemoryLimiter()
+	defer limiter.Close()
+
+	rule := Rule{Requests: 1, Window: time.Minute}
+	ctx := context.Background()
+
+	if res, _ := limiter.Allow(ctx, "a", rule); !res.Allowed {
+		t.Fatal("first request for a should be allowed")
+	}
+	if res, _ := limiter.Allow(ctx, "a", rule); res.Allowed {
+		t.Fatal("second request for a should be limited")
+	}
+	if res, _ := limiter.Allow(ctx, "b", rule); !res.Allowed {
+		t.Fatal("first request for b should be allowed")
+	}
+}
+
+func TestResultHeaders(t *testing.T) {
+	rule := Rule{Requests: 10, Window: 10 * time.Second, Burst: 5}
+	res := newResult(rule, false, 0.5)
+
+	headers := res.Headers(rule)
+	expected := map[string]string{
+		"RateLimit-Limit":     "5",
+		"RateLimit-Remaining": "0",
+		"RateLimit-Reset":     "5",
+		"RateLimit-Policy":    "10;w=10",
+		"Retry-After":         "1",
+	}
+	for name, value := range expected {
+		if headers[name] != value {
+			t.Errorf("%s = %q, want %q", name, headers[name], value)
+		}
+	}
+}
+`
+}
+
+// generateRateLimitMiddleware создает internal/middleware/ratelimit.go для выбранного фреймворка
+func (g *Generator) generateRateLimitMiddleware(config *ProjectConfig) error {
+	var content string
+
+	switch strings.ToLower(config.Framework) {
+	case "fiber":
+		content = fmt.Sprintf(`package middleware
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/gofiber/fiber/v2"
+
+	"%s/internal/config"
+	appcontext "%s/pkg/context"
+	"%s/pkg/logger"
+	"%s/pkg/ratelimit"
+)
+
+// RateLimitMiddleware ограничивает частоту запросов группы маршрутов.
+// Лимиты и способ определения клиента берутся из актуальной конфигурации
+func RateLimitMiddleware(limiter ratelimit.Limiter, rateLimit *config.Notifier[config.RateLimitConfig], group string, log logger.Logger) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		cfg := rateLimit.Get()
+		rule := ratelimit.RuleFor(cfg, group)
+		key := rateLimitKey(c.UserContext(), cfg, group, c.IP(), c.Get(apiKeyHeader(cfg)))
+
+		res, err := limiter.Allow(c.UserContext(), key, rule)
+		if err != nil {
+			log.WithContext(c.UserContext()).Error("Ошибка rate limiting, запрос пропущен", "path", c.Path(), "error", err)
+			return c.Next()
+		}
+
+		for name, value := range res.Headers(rule) {
+			c.Set(name, value)
+		}
+		if !res.Allowed {
+			return c.Status(fiber.StatusTooManyRequests).JSON(fiber.Map{"error": "слишком много запросов"})
+		}
+		return c.Next()
+	}
+}
+`, config.ModuleName, config.ModuleName, config.ModuleName, config.ModuleName)
+	case "echo":
+		content = fmt.Sprintf(`package middleware
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"%s/internal/config"
+	appcontext "%s/pkg/context"
+	"%s/pkg/logger"
+	"%s/pkg/ratelimit"
+)
+
+// RateLimitMiddleware ограничивает частоту запросов группы маршрутов.
+// Лимиты и способ определения клиента берутся из актуальной конфигурации
+func RateLimitMiddleware(limiter ratelimit.Limiter, rateLimit *config.Notifier[config.RateLimitConfig], group string, log logger.Logger) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			cfg := rateLimit.Get()
+			rule := ratelimit.RuleFor(cfg, group)
+			key := rateLimitKey(req.Context(), cfg, group, c.RealIP(), req.Header.Get(apiKeyHeader(cfg)))
+
+			res, err := limiter.Allow(req.Context(), key, rule)
+			if err != nil {
+				log.WithContext(req.Context()).Error("Ошибка rate limiting, запрос пропущен", "path", req.URL.Path, "error", err)
+				return next(c)
+			}
+
+			for name, value := range res.Headers(rule) {
+				c.Response().Header().Set(name, value)
+			}
+			if !res.Allowed {
+				return c.JSON(http.StatusTooManyRequests, map[string]string{"error": "слишком много запросов"})
+			}
+			return next(c)
+		}
+	}
+}
+`, config.ModuleName, config.ModuleName, config.ModuleName, config.ModuleName)
+	default:
+		content = fmt.Sprintf(`package middleware
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"%s/internal/config"
+	appcontext "%s/pkg/context"
+	"%s/pkg/logger"
+	"%s/pkg/ratelimit"
+)
+
+// RateLimitMiddleware ограничивает частоту запросов группы маршрутов.
+// Лимиты и способ определения клиента берутся из актуальной конфигурации
+func RateLimitMiddleware(limiter ratelimit.Limiter, rateLimit *config.Notifier[config.RateLimitConfig], group string, log logger.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		cfg := rateLimit.Get()
+		rule := ratelimit.RuleFor(cfg, group)
+		key := rateLimitKey(c.Request.Context(), cfg, group, c.ClientIP(), c.GetHeader(apiKeyHeader(cfg)))
+
+		res, err := limiter.Allow(c.Request.Context(), key, rule)
+		if err != nil {
+			log.WithContext(c.Request.Context()).Error("Ошибка rate limiting, запрос пропущен", "path", c.Request.URL.Path, "error", err)
+			c.Next()
+			return
+		}
+
+		for name, value := range res.Headers(rule) {
+			c.Header(name, value)
+		}
+		if !res.Allowed {
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{"error": "слишком много запросов"})
+			return
+		}
+		c.Next()
+	}
+}
+`, config.ModuleName, config.ModuleName, config.ModuleName, config.ModuleName)
+	}
+
+	content += `
+// rateLimitKey формирует ключ клиента по настройке key_by.
+// Если API ключ или пользователь не определены, используется IP адрес
+func rateLimitKey(ctx context.Context, cfg config.RateLimitConfig, group, ip, apiKey string) string {
+	switch cfg.KeyBy {
+	case "api_key":
+		if apiKey != "" {
+			sum := sha256.Sum256([]byte(apiKey))
+			return group + ":key:" + hex.EncodeToString(sum[:8])
+		}
+	case "user":
+		if appCtx, ok := appcontext.FromContext(ctx); ok && appCtx.UserID() != "" {
+			return group + ":user:" + appCtx.UserID()
+		}
+	}
+	return group + ":ip:" + ip
+}
+
+// apiKeyHeader возвращает заголовок с API ключом
+func apiKeyHeader(cfg config.RateLimitConfig) string {
+	if cfg.APIKeyHeader != "" {
+		return cfg.APIKeyHeader
+	}
+	return "X-API-Key"
+}
+`
+
+	rateLimitPath := filepath.Join(g.projectPath, "internal/middleware/ratelimit.go")
+	return os.WriteFile(rateLimitPath, []byte(content), 0644)
+}
@@ -0,0 +1,360 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// generateConfigReload создает internal/config/reload.go: уведомления об изменении секций и отслеживание файла
+func (g *Generator) generateConfigReload() error {
+	files := map[string]string{
+		"reload.go":      g.generateConfigReloadGo(),
+		"reload_test.go": g.generateConfigReloadTest(),
+	}
+
+	for name, content := range files {
+		path := filepath.Join(g.projectPath, "internal/config", name)
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// generateConfigReloadGo генерирует перезагрузку конфигурации без перезапуска
+func (g *Generator) generateConfigReloadGo() string {
+	return `package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Notifier хранит текущее значение перезагружаемой секции и сообщает подписчикам о его изменении
+type Notifier[T any] struct {
+	value       atomic.Pointer[T]
+	mu          sync.Mutex
+	subscribers []func(T)
+}
+
+func newNotifier[T any](value T) *Notifier[T] {
+	n := &Notifier[T]{}
+	n.value.Store(&value)
+	return n
+}
+
+// Get возвращает текущее значение
+func (n *Notifier[T]) Get() T {
+	return *n.value.Load()
+}
+
+// Subscribe вызывает fn после каждого изменения значения. fn не должен блокироваться надолго
+func (n *Notifier[T]) Subscribe(fn func(T)) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.subscribers = append(n.subscribers, fn)
+}
+
+// publish сохраняет value и уведомляет подписчиков, если значение изменилось
+func (n *Notifier[T]) publish(value T) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if reflect.DeepEqual(*n.value.Load(), value) {
+		return
+	}
+	n.value.Store(&value)
+	for _, fn := range n.subscribers {
+		fn(value)
+	}
+}
+
+// Changes секции, которые применяются без перезапуска. Остальные поля Config не меняются после Load
+type Changes struct {
+	Logger    *Notifier[LoggerConfig]
+	CORS      *Notifier[CORSConfig]
+	RateLimit *Notifier[RateLimitConfig]
+	Features  *Notifier[map[string]bool]
+}
+
+var changesMu sync.Mutex
+
+// Changes возвращает текущие значения перезагружаемых секций. До первой перезагрузки они равны полям c
+func (c *Config) Changes() *Changes {
+	changesMu.Lock()
+	defer changesMu.Unlock()
+
+	if c.changes == nil {
+		c.changes = &Changes{
+			Logger:    newNotifier(c.Logger),
+			CORS:      newNotifier(c.Middleware.CORS),
+			RateLimit: newNotifier(c.Middleware.RateLimit),
+			Features:  newNotifier(c.Features),
+		}
+	}
+	return c.changes
+}
+
+// FeatureEnabled проверяет флаг из секции features с учетом перезагрузки
+func (c *Config) FeatureEnabled(name string) bool {
+	return c.Changes().Features.Get()[name]
+}
+
+// ReloadRejectedError перезагрузка отклонена: изменены настройки, которые применяются только при запуске
+type ReloadRejectedError struct {
+	Fields []string
+}
+
+func (e *ReloadRejectedError) Error() string {
+	return "изменения требуют перезапуска: " + strings.Join(e.Fields, ", ")
+}
+
+// Reload перечитывает конфигурацию теми же слоями и опциями, что и Load, и публикует перезагружаемые секции.
+// Если изменилось что-то еще, возвращается *ReloadRejectedError и не применяется ничего
+func (c *Config) Reload() error {
+	if c.path == "" {
+		return errors.New("конфигурация загружена не через Load, перечитывать нечего")
+	}
+
+	next, err := Load(c.path, c.options...)
+	if err != nil {
+		return err
+	}
+
+	if fields := structuralChanges(c, next); len(fields) > 0 {
+		return &ReloadRejectedError{Fields: fields}
+	}
+
+	changes := c.Changes()
+	changes.Logger.publish(next.Logger)
+	changes.CORS.publish(next.Middleware.CORS)
+	changes.RateLimit.publish(next.Middleware.RateLimit)
+	changes.Features.publish(next.Features)
+
+	return nil
+}
+
+// Watch проверяет файлы конфигурации каждые interval и вызывает Reload, если они изменились после Load.
+// onReload получает результат каждой перезагрузки; при ошибке продолжают действовать прежние значения
+func (c *Config) Watch(ctx context.Context, interval time.Duration, onReload func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	last := c.stamp
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			current := c.fingerprint()
+			if current == last {
+				continue
+			}
+			last = current
+
+			err := c.Reload()
+			if onReload != nil {
+				onReload(err)
+			}
+		}
+	}
+}
+
+// fingerprint время изменения и размер файлов, из которых читается конфигурация
+func (c *Config) fingerprint() string {
+	files := []string{c.path}
+	if env := os.Getenv("APP_ENV"); env != "" {
+		files = append(files, ProfilePath(c.path, env))
+	}
+
+	var b strings.Builder
+	for _, file := range files {
+		if info, err := os.Stat(file); err == nil {
+			fmt.Fprintf(&b, "%s:%d:%d;", file, info.ModTime().UnixNano(), info.Size())
+		}
+	}
+	return b.String()
+}
+
+// structural возвращает копию без перезагружаемых полей. Включение CORS и rate limiting,
+// backend и Redis лимитера, формат и выходы логов остаются структурными
+func (c Config) structural() Config {
+	c.Logger.Level = ""
+	c.Logger.Packages = nil
+	c.Middleware.CORS = CORSConfig{Enabled: c.Middleware.CORS.Enabled}
+
+	rateLimit := c.Middleware.RateLimit
+	c.Middleware.RateLimit = RateLimitConfig{
+		Enabled: rateLimit.Enabled,
+		Backend: rateLimit.Backend,
+		Redis:   rateLimit.Redis,
+	}
+
+	c.Features = nil
+	c.path, c.options, c.stamp, c.changes = "", nil, "", nil
+	return c
+}
+
+// structuralChanges возвращает пути полей (app.port, database.type), которые нельзя применить без перезапуска
+func structuralChanges(current, next *Config) []string {
+	var fields []string
+	diffFields(reflect.ValueOf(current.structural()), reflect.ValueOf(next.structural()), "", &fields)
+	return fields
+}
+
+func diffFields(a, b reflect.Value, path string, fields *[]string) {
+	t := a.Type()
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("yaml"), ",")[0]
+		if name == "" || name == "-" {
+			continue
+		}
+
+		fieldPath := strings.TrimPrefix(path+"."+name, ".")
+		if a.Field(i).Kind() == reflect.Struct {
+			diffFields(a.Field(i), b.Field(i), fieldPath, fields)
+			continue
+		}
+		if !reflect.DeepEqual(a.Field(i).Interface(), b.Field(i).Interface()) {
+			*fields = append(*fields, fieldPath)
+		}
+	}
+}
+`
+}
+
+// generateConfigReloadTest генерирует тесты перезагрузки конфигурации
+func (g *Generator) generateConfigReloadTest() string {
+	return `package config
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestNotifier(t *testing.T) {
+	n := newNotifier(CORSConfig{AllowOrigins: []string{"https://a.example.com"}})
+
+	var calls int
+	n.Subscribe(func(CORSConfig) { calls++ })
+
+	n.publish(CORSConfig{AllowOrigins: []string{"https://a.example.com"}})
+	n.publish(CORSConfig{AllowOrigins: []string{"https://b.example.com"}})
+
+	if calls != 1 {
+		t.Errorf("expected 1 notification, got %d", calls)
+	}
+	if got := n.Get().AllowOrigins; !reflect.DeepEqual(got, []string{"https://b.example.com"}) {
+		t.Errorf("unexpected current value: %v", got)
+	}
+}
+
+// loadTestConfig записывает cfg в файл и загружает его через Load
+func loadTestConfig(t *testing.T, cfg *Config) (*Config, string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	writeConfig(t, path, cfg)
+
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	return loaded, path
+}
+
+func writeConfig(t *testing.T, path string, cfg *Config) {
+	t.Helper()
+	data, err := yaml.Marshal(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeFile(t, path, string(data))
+}
+
+func TestReloadAppliesRuntimeSettings(t *testing.T) {
+	cfg, path := loadTestConfig(t, validConfig())
+
+	var level string
+	cfg.Changes().Logger.Subscribe(func(logger LoggerConfig) { level = logger.Level })
+
+	next := validConfig()
+	next.Logger.Level = "warn"
+	next.Middleware.RateLimit.Default = RateLimitRule{Requests: 10, Window: 1, Burst: 10}
+	next.Features = map[string]bool{"new_checkout": true}
+	writeConfig(t, path, next)
+
+	if err := cfg.Reload(); err != nil {
+		t.Fatalf("Reload failed: %v", err)
+	}
+	if level != "warn" {
+		t.Errorf("logger subscriber got level %q, want warn", level)
+	}
+	if cfg.Changes().RateLimit.Get().Default.Requests != 10 {
+		t.Errorf("rate limit not reloaded: %+v", cfg.Changes().RateLimit.Get())
+	}
+	if !cfg.FeatureEnabled("new_checkout") {
+		t.Error("feature flag not reloaded")
+	}
+}
+
+func TestReloadRejectsStructuralChanges(t *testing.T) {
+	cfg, path := loadTestConfig(t, validConfig())
+
+	next := validConfig()
+	next.App.Port = 9000
+	next.Logger.Level = "error"
+	writeConfig(t, path, next)
+
+	var rejected *ReloadRejectedError
+	if err := cfg.Reload(); !errors.As(err, &rejected) {
+		t.Fatalf("expected *ReloadRejectedError, got %v", err)
+	}
+	if !reflect.DeepEqual(rejected.Fields, []string{"app.port"}) {
+		t.Errorf("unexpected rejected fields: %v", rejected.Fields)
+	}
+	if cfg.Changes().Logger.Get().Level != cfg.Logger.Level {
+		t.Error("rejected reload must not publish changes")
+	}
+}
+
+func TestWatch(t *testing.T) {
+	cfg, path := loadTestConfig(t, validConfig())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	reloaded := make(chan error, 1)
+	go cfg.Watch(ctx, 10*time.Millisecond, func(err error) { reloaded <- err })
+
+	next := validConfig()
+	next.Features = map[string]bool{"beta": true}
+	writeConfig(t, path, next)
+
+	select {
+	case err := <-reloaded:
+		if err != nil {
+			t.Fatalf("reload failed: %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("config change was not detected")
+	}
+	if !cfg.FeatureEnabled("beta") {
+		t.Error("feature flag not reloaded")
+	}
+}
+`
+}
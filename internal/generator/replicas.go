@@ -0,0 +1,534 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// supportsReplicas проверяет, читает ли реализация Database из реплик database.replicas (PostgreSQL и MySQL)
+func supportsReplicas(config *ProjectConfig) bool {
+	database := strings.ToLower(config.Database)
+	return database == "postgresql" || database == "mysql"
+}
+
+// hasReadRouting проверяет, нужны ли sticky primary и datasources: реплики SQL или read preference MongoDB
+func hasReadRouting(config *ProjectConfig) bool {
+	return supportsReplicas(config) || strings.ToLower(config.Database) == "mongodb"
+}
+
+// datasourcesExample возвращает закомментированный пример секции datasources для config.yaml
+func datasourcesExample(config *ProjectConfig) string {
+	content := `# Дополнительные БД того же типа, подключение - database.NewDatasource(cfg, "<имя>")
+# datasources:
+#   analytics:
+`
+	switch strings.ToLower(config.Database) {
+	case "postgresql":
+		content += `#     type: "postgres"
+#     host: "localhost"
+#     port: 5432
+#     user: "postgres"
+#     name: "analytics"
+#     ssl_mode: "disable"
+#     max_connections: 20
+`
+	case "mysql":
+		content += `#     type: "mysql"
+#     host: "localhost"
+#     port: 3306
+#     user: "root"
+#     name: "analytics"
+#     charset: "utf8mb4"
+#     max_connections: 20
+`
+	case "mongodb":
+		content += `#     type: "mongodb"
+#     uri: "mongodb://localhost:27017"
+#     name: "analytics"
+#     timeout: 30
+`
+	}
+
+	return content + `
+`
+}
+
+// generateReplicas создает pkg/database/replicas.go (sticky primary, datasources, реплики) и
+// middleware, отмечающий границы запроса для sticky primary
+func (g *Generator) generateReplicas(config *ProjectConfig) error {
+	if !hasReadRouting(config) {
+		return nil
+	}
+
+	files := map[string]string{
+		"pkg/database/replicas.go":        g.generateReplicaRouting(config),
+		"internal/middleware/database.go": g.generateStickyPrimaryMiddleware(config),
+	}
+
+	if supportsReplicas(config) {
+		files["pkg/database/replicas_test.go"] = g.generateReplicaRoutingTest(config)
+	}
+
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(g.projectPath, name), []byte(content), 0644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// generateReplicaRouting генерирует sticky primary, NewDatasource и, для SQL, набор реплик с выбором по кругу
+func (g *Generator) generateReplicaRouting(config *ProjectConfig) string {
+	std := []string{`"context"`, `"fmt"`, `"sync/atomic"`}
+	var imports []string
+	var ops string
+
+	if supportsReplicas(config) {
+		std = append(std, `"errors"`)
+		imports, ops = replicaOps(config)
+		if dataAccess(config) != "pgx" {
+			std = append(std, `"database/sql"`)
+		}
+	}
+
+	content := `package database
+
+import (` + sortedImports(std...) + `
+`
+	if len(imports) > 0 {
+		content += sortedImports(imports...) + `
+`
+	}
+
+	content += `
+	"` + config.ModuleName + `/internal/config"
+)
+
+// stickyKey ключ признака записи в контексте запроса
+type stickyKey struct{}
+
+// WithStickyPrimary готовит контекст запроса к sticky primary: после записи с этим контекстом
+// чтения с ним идут в primary и видят свои изменения, даже если реплики отстают
+func WithStickyPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, stickyKey{}, new(atomic.Bool))
+}
+
+// MarkWritten отмечает запись в контексте из WithStickyPrimary, для остальных контекстов ничего не делает
+func MarkWritten(ctx context.Context) {
+	if written, ok := ctx.Value(stickyKey{}).(*atomic.Bool); ok {
+		written.Store(true)
+	}
+}
+
+// ReadFromPrimary сообщает, что чтение с ctx должно идти в primary: в транзакции или после записи в том же запросе
+func ReadFromPrimary(ctx context.Context) bool {
+	if TxFromContext(ctx) != nil {
+		return true
+	}
+	written, ok := ctx.Value(stickyKey{}).(*atomic.Bool)
+	return ok && written.Load()
+}
+
+// NewDatasource подключается к именованной БД из datasources, остальные настройки берутся из cfg
+func NewDatasource(cfg *config.Config, name string) (Database, error) {
+	dbCfg, ok := cfg.Datasources[name]
+	if !ok {
+		return nil, fmt.Errorf("datasource %q не настроен", name)
+	}
+
+	dsCfg := *cfg
+	dsCfg.Database = dbCfg
+	return New(&dsCfg)
+}
+`
+
+	if !supportsReplicas(config) {
+		return content
+	}
+
+	content += `
+// replica подключение к реплике для чтения
+type replica[T any] struct {
+	name string
+	conn T
+}
+
+// connOps операции драйвера над подключением, которые нужны replicaSet
+type connOps[T any] struct {
+	open  func(dbCfg config.DatabaseConfig) (T, error)
+	stats func(conn T) Stats
+	ping  func(ctx context.Context, conn T) error
+	close func(conn T) error
+}
+
+// replicaSet реплики для чтения, которые выбираются по кругу
+type replicaSet[T any] struct {
+	ops      connOps[T]
+	replicas []replica[T]
+	next     atomic.Uint64
+}
+
+// openReplicas подключается к репликам из dbCfg.Replicas, при ошибке закрывает уже открытые
+func openReplicas[T any](dbCfg config.DatabaseConfig, ops connOps[T]) (*replicaSet[T], error) {
+	set := &replicaSet[T]{ops: ops}
+	for _, rc := range dbCfg.Replicas {
+		conn, err := ops.open(dbCfg.Replica(rc))
+		if err != nil {
+			_ = set.close()
+			return nil, fmt.Errorf("ошибка подключения к реплике %s: %w", rc.Name, err)
+		}
+		set.replicas = append(set.replicas, replica[T]{name: rc.Name, conn: conn})
+	}
+	return set, nil
+}
+
+// pick возвращает подключение для чтения с ctx: следующую реплику или primary,
+// если реплик нет или ReadFromPrimary(ctx)
+func (s *replicaSet[T]) pick(ctx context.Context, primary T) T {
+	if len(s.replicas) == 0 || ReadFromPrimary(ctx) {
+		return primary
+	}
+	n := s.next.Add(1) - 1
+	return s.replicas[n%uint64(len(s.replicas))].conn
+}
+
+// names возвращает имена реплик
+func (s *replicaSet[T]) names() []string {
+	names := make([]string, 0, len(s.replicas))
+	for _, r := range s.replicas {
+		names = append(names, r.name)
+	}
+	return names
+}
+
+// stats возвращает статистику пула каждой реплики
+func (s *replicaSet[T]) stats() []ReplicaStats {
+	stats := make([]ReplicaStats, 0, len(s.replicas))
+	for _, r := range s.replicas {
+		pool := s.ops.stats(r.conn)
+		stats = append(stats, ReplicaStats{
+			Name:             r.name,
+			OpenConnections:  pool.OpenConnections,
+			InUseConnections: pool.InUseConnections,
+			IdleConnections:  pool.IdleConnections,
+		})
+	}
+	return stats
+}
+
+// ping проверяет подключение к реплике name
+func (s *replicaSet[T]) ping(ctx context.Context, name string) error {
+	for _, r := range s.replicas {
+		if r.name == name {
+			return s.ops.ping(ctx, r.conn)
+		}
+	}
+	return fmt.Errorf("реплика %s не настроена", name)
+}
+
+// close закрывает подключения ко всем репликам
+func (s *replicaSet[T]) close() error {
+	var errs []error
+	for _, r := range s.replicas {
+		if err := s.ops.close(r.conn); err != nil {
+			errs = append(errs, fmt.Errorf("реплика %s: %w", r.name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+` + ops
+
+	return content
+}
+
+// replicaOps возвращает импорты и connOps для подключения выбранного слоя доступа
+func replicaOps(config *ProjectConfig) ([]string, string) {
+	poolStats := `
+// poolStats переводит статистику пула database/sql в Stats
+func poolStats(stats sql.DBStats) Stats {
+	return Stats{
+		OpenConnections:  stats.OpenConnections,
+		InUseConnections: stats.InUse,
+		IdleConnections:  stats.Idle,
+	}
+}
+`
+
+	switch dataAccess(config) {
+	case "pgx":
+		return []string{`"github.com/jackc/pgx/v5/pgxpool"`}, `
+// pgxOps операции над пулом pgx, open подключается к серверу из dbCfg
+func pgxOps(open func(dbCfg config.DatabaseConfig) (*pgxpool.Pool, error)) connOps[*pgxpool.Pool] {
+	return connOps[*pgxpool.Pool]{
+		open:  open,
+		stats: pgxStats,
+		ping: func(ctx context.Context, pool *pgxpool.Pool) error {
+			return pool.Ping(ctx)
+		},
+		close: func(pool *pgxpool.Pool) error {
+			pool.Close()
+			return nil
+		},
+	}
+}
+
+// pgxStats переводит статистику пула pgx в Stats
+func pgxStats(pool *pgxpool.Pool) Stats {
+	stat := pool.Stat()
+	return Stats{
+		OpenConnections:  int(stat.TotalConns()),
+		InUseConnections: int(stat.AcquiredConns()),
+		IdleConnections:  int(stat.IdleConns()),
+	}
+}
+`
+	case "sqlx", "sqlc":
+		handle, imports := "*sql.DB", []string(nil)
+		if dataAccess(config) == "sqlx" {
+			handle, imports = "*sqlx.DB", []string{`"github.com/jmoiron/sqlx"`}
+		}
+		return imports, `
+// sqlOps операции над пулом database/sql, open подключается к серверу из dbCfg
+func sqlOps(open func(dbCfg config.DatabaseConfig) (` + handle + `, error)) connOps[` + handle + `] {
+	return connOps[` + handle + `]{
+		open: open,
+		stats: func(db ` + handle + `) Stats {
+			return poolStats(db.Stats())
+		},
+		ping: func(ctx context.Context, db ` + handle + `) error {
+			return db.PingContext(ctx)
+		},
+		close: func(db ` + handle + `) error {
+			return db.Close()
+		},
+	}
+}
+` + poolStats
+	default:
+		return []string{`"gorm.io/gorm"`}, `
+// gormOps операции над подключением GORM, open подключается к серверу из dbCfg
+func gormOps(open func(dbCfg config.DatabaseConfig) (*gorm.DB, error)) connOps[*gorm.DB] {
+	return connOps[*gorm.DB]{
+		open: open,
+		stats: func(db *gorm.DB) Stats {
+			sqlDB, err := db.DB()
+			if err != nil {
+				return Stats{}
+			}
+			return poolStats(sqlDB.Stats())
+		},
+		ping: func(ctx context.Context, db *gorm.DB) error {
+			sqlDB, err := db.DB()
+			if err != nil {
+				return err
+			}
+			return sqlDB.PingContext(ctx)
+		},
+		close: func(db *gorm.DB) error {
+			sqlDB, err := db.DB()
+			if err != nil {
+				return err
+			}
+			return sqlDB.Close()
+		},
+	}
+}
+` + poolStats
+	}
+}
+
+// replicaMethods генерирует Reader, ReplicaNames и PingReplica реализации Database с полем replicas
+func replicaMethods(receiver, name, handle, primary string) string {
+	r := receiver
+	return `
+// Reader возвращает подключение для чтения с ctx: реплику по кругу или primary,
+// если реплик нет, ctx в транзакции или в этом запросе уже была запись
+func (` + r + ` *` + name + `Database) Reader(ctx context.Context) ` + handle + ` {
+	return ` + r + `.replicas.pick(ctx, ` + primary + `)
+}
+
+// ReplicaNames возвращает имена реплик для чтения
+func (` + r + ` *` + name + `Database) ReplicaNames() []string {
+	return ` + r + `.replicas.names()
+}
+
+// PingReplica проверяет подключение к реплике name
+func (` + r + ` *` + name + `Database) PingReplica(ctx context.Context, name string) error {
+	return ` + r + `.replicas.ping(ctx, name)
+}
+`
+}
+
+// generateReplicaRoutingTest генерирует тест выбора реплик и sticky primary без подключения к БД
+func (g *Generator) generateReplicaRoutingTest(config *ProjectConfig) string {
+	return `package database
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"` + config.ModuleName + `/internal/config"
+)
+
+// testTx транзакция-заглушка для контекста с транзакцией
+type testTx struct{ Tx }
+
+// newTestReplicaSet набор реплик, где подключение - имя реплики, а реплика failing недоступна
+func newTestReplicaSet(failing string, names ...string) *replicaSet[string] {
+	set := &replicaSet[string]{ops: connOps[string]{
+		stats: func(conn string) Stats { return Stats{OpenConnections: len(conn)} },
+		ping: func(_ context.Context, conn string) error {
+			if conn == failing {
+				return errors.New("реплика недоступна")
+			}
+			return nil
+		},
+		close: func(string) error { return nil },
+	}}
+	for _, name := range names {
+		set.replicas = append(set.replicas, replica[string]{name: name, conn: name})
+	}
+	return set
+}
+
+func TestReplicaSetRoundRobin(t *testing.T) {
+	set := newTestReplicaSet("", "replica-1", "replica-2")
+
+	for i, expected := range []string{"replica-1", "replica-2", "replica-1"} {
+		if got := set.pick(context.Background(), "primary"); got != expected {
+			t.Fatalf("pick %d: expected %s, got %s", i, expected, got)
+		}
+	}
+}
+
+func TestReplicaSetWithoutReplicasReadsPrimary(t *testing.T) {
+	if got := newTestReplicaSet("").pick(context.Background(), "primary"); got != "primary" {
+		t.Errorf("expected primary, got %s", got)
+	}
+}
+
+func TestStickyPrimaryAfterWrite(t *testing.T) {
+	set := newTestReplicaSet("", "replica-1")
+	ctx := WithStickyPrimary(context.Background())
+
+	if got := set.pick(ctx, "primary"); got != "replica-1" {
+		t.Fatalf("expected replica before write, got %s", got)
+	}
+
+	MarkWritten(ctx)
+	if got := set.pick(ctx, "primary"); got != "primary" {
+		t.Errorf("expected primary after write, got %s", got)
+	}
+
+	// Запись в одном запросе не влияет на другие
+	if got := set.pick(WithStickyPrimary(context.Background()), "primary"); got != "replica-1" {
+		t.Errorf("expected replica for another request, got %s", got)
+	}
+}
+
+func TestReadFromPrimaryInTransaction(t *testing.T) {
+	set := newTestReplicaSet("", "replica-1")
+	ctx := contextWithTx(context.Background(), testTx{})
+
+	if got := set.pick(ctx, "primary"); got != "primary" {
+		t.Errorf("expected primary in transaction, got %s", got)
+	}
+}
+
+func TestReplicaSetPingAndStats(t *testing.T) {
+	set := newTestReplicaSet("replica-2", "replica-1", "replica-2")
+	ctx := context.Background()
+
+	if err := set.ping(ctx, "replica-1"); err != nil {
+		t.Errorf("replica-1: unexpected error: %v", err)
+	}
+	if err := set.ping(ctx, "replica-2"); err == nil {
+		t.Error("replica-2: expected error")
+	}
+	if err := set.ping(ctx, "replica-3"); err == nil {
+		t.Error("replica-3: expected error for unknown replica")
+	}
+
+	stats := set.stats()
+	if len(stats) != 2 || stats[1].Name != "replica-2" || stats[1].OpenConnections != len("replica-2") {
+		t.Errorf("unexpected stats: %+v", stats)
+	}
+}
+
+func TestOpenReplicasClosesOpenedOnError(t *testing.T) {
+	var closed []string
+	ops := connOps[string]{
+		open: func(dbCfg config.DatabaseConfig) (string, error) {
+			if dbCfg.Host == "down" {
+				return "", errors.New("connection refused")
+			}
+			return dbCfg.Host, nil
+		},
+		close: func(conn string) error {
+			closed = append(closed, conn)
+			return nil
+		},
+	}
+
+	dbCfg := config.DatabaseConfig{Replicas: []config.DatabaseReplicaConfig{
+		{Name: "replica-1", Host: "up", Port: 5433},
+		{Name: "replica-2", Host: "down", Port: 5434},
+	}}
+
+	if _, err := openReplicas(dbCfg, ops); err == nil {
+		t.Fatal("expected error")
+	}
+	if len(closed) != 1 || closed[0] != "up" {
+		t.Errorf("expected opened replica to be closed, got %v", closed)
+	}
+}
+`
+}
+
+// generateStickyPrimaryMiddleware генерирует StickyPrimaryMiddleware для выбранного фреймворка
+func (g *Generator) generateStickyPrimaryMiddleware(config *ProjectConfig) string {
+	var framework, handler, body string
+
+	switch strings.ToLower(config.Framework) {
+	case "fiber":
+		framework, handler = `"github.com/gofiber/fiber/v2"`, "fiber.Handler"
+		body = `	return func(c *fiber.Ctx) error {
+		c.SetUserContext(database.WithStickyPrimary(c.UserContext()))
+		return c.Next()
+	}`
+	case "echo":
+		framework, handler = `"github.com/labstack/echo/v4"`, "echo.MiddlewareFunc"
+		body = `	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			c.SetRequest(req.WithContext(database.WithStickyPrimary(req.Context())))
+			return next(c)
+		}
+	}`
+	default:
+		framework, handler = `"github.com/gin-gonic/gin"`, "gin.HandlerFunc"
+		body = `	return func(c *gin.Context) {
+		c.Request = c.Request.WithContext(database.WithStickyPrimary(c.Request.Context()))
+		c.Next()
+	}`
+	}
+
+	return `package middleware
+
+import (
+	` + framework + `
+
+	"` + config.ModuleName + `/pkg/database"
+)
+
+// StickyPrimaryMiddleware задает границы запроса для sticky primary: после записи в БД
+// чтения этого запроса идут в primary, а не в реплику, которая могла еще не получить изменения.
+// Должен стоять до RequestIDMiddleware, чтобы контекст попал в AppContext репозиториев
+func StickyPrimaryMiddleware() ` + handler + ` {
+` + body + `
+}
+`
+}
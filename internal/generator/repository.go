@@ -0,0 +1,746 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// generateRepositories создает pkg/database/errors.go и UserRepository для выбранной БД и слоя доступа.
+// Для ClickHouse вместо пользователей создается EventRepository аналитических событий
+func (g *Generator) generateRepositories(config *ProjectConfig) error {
+	files := map[string]string{
+		"pkg/database/errors.go": g.generateDatabaseErrors(config),
+	}
+
+	if repositoryKind(config) == "clickhouse" {
+		files["internal/repository/event.go"] = g.generateEventRepository(config)
+	} else {
+		files["internal/repository/user.go"] = g.generateUserRepository(config)
+	}
+
+	// Репозиторий на SQLite проверяется без внешней БД
+	if isSQLite(config) {
+		files["internal/repository/user_test.go"] = g.generateUserRepositoryTest(config)
+	}
+
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(g.projectPath, name), []byte(content), 0644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// repositoryKind возвращает реализацию репозитория: слой доступа для SQL, clickhouse или mongo
+func repositoryKind(config *ProjectConfig) string {
+	if access := dataAccess(config); access != "" {
+		return access
+	}
+	if strings.ToLower(config.Database) == "clickhouse" {
+		return "clickhouse"
+	}
+	return "mongo"
+}
+
+// generateDatabaseErrors генерирует ErrNotFound, ErrConflict и перевод в них ошибок драйвера
+func (g *Generator) generateDatabaseErrors(config *ProjectConfig) string {
+	var std, imports []string
+	var notFound, conflict string
+
+	switch repositoryKind(config) {
+	case "gorm":
+		imports = []string{`"gorm.io/gorm"`}
+		notFound = "errors.Is(err, gorm.ErrRecordNotFound)"
+		conflict = "errors.Is(err, gorm.ErrDuplicatedKey)"
+	case "pgx":
+		imports = []string{`"github.com/jackc/pgx/v5"`, `"github.com/jackc/pgx/v5/pgconn"`}
+		notFound = "errors.Is(err, pgx.ErrNoRows)"
+		conflict = "isUniqueViolation(err)"
+	case "mongo":
+		imports = []string{`"go.mongodb.org/mongo-driver/mongo"`}
+		notFound = "errors.Is(err, mongo.ErrNoDocuments)"
+		conflict = "mongo.IsDuplicateKeyError(err)"
+	case "clickhouse":
+		// В ClickHouse нет уникальных ограничений, ErrConflict не возникает
+		std = []string{`"database/sql"`}
+		notFound = "errors.Is(err, sql.ErrNoRows)"
+	default:
+		std = []string{`"database/sql"`}
+		notFound = "errors.Is(err, sql.ErrNoRows)"
+		conflict = "isUniqueViolation(err)"
+		switch strings.ToLower(config.Database) {
+		case "mysql":
+			imports = []string{`"github.com/go-sql-driver/mysql"`}
+		case "in-memory":
+			imports = []string{`"github.com/mattn/go-sqlite3"`}
+		case "sqlite":
+			imports = []string{`"modernc.org/sqlite"`, `sqlite3 "modernc.org/sqlite/lib"`}
+		default:
+			imports = []string{`"github.com/jackc/pgx/v5/pgconn"`}
+		}
+	}
+
+	if conflict != "" {
+		std = append(std, `"fmt"`)
+	}
+
+	content := `package database
+
+import (` + sortedImports(append(std, `"errors"`)...) + `
+`
+	if len(imports) > 0 {
+		content += sortedImports(imports...) + `
+`
+	}
+	content += `)
+
+var (
+	// ErrNotFound запись не найдена
+	ErrNotFound = errors.New("запись не найдена")
+	// ErrConflict запись нарушает ограничение уникальности, например занятый email
+	ErrConflict = errors.New("запись уже существует")
+)
+
+// TranslateError приводит ошибку драйвера к ErrNotFound или ErrConflict, остальные возвращает как есть.
+// Исходная ошибка конфликта сохраняется в тексте для логов
+func TranslateError(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case ` + notFound + `:
+		return ErrNotFound`
+	if conflict != "" {
+		content += `
+	case ` + conflict + `:
+		return fmt.Errorf("%w: %v", ErrConflict, err)`
+	}
+	content += `
+	default:
+		return err
+	}
+}
+`
+
+	if conflict != "isUniqueViolation(err)" {
+		return content
+	}
+
+	content += `
+// isUniqueViolation проверяет нарушение уникального индекса
+func isUniqueViolation(err error) bool {
+`
+	switch {
+	case strings.ToLower(config.Database) == "mysql":
+		content += `	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == 1062
+}
+`
+	case strings.ToLower(config.Database) == "in-memory":
+		content += `	var sqliteErr sqlite3.Error
+	return errors.As(err, &sqliteErr) && sqliteErr.ExtendedCode == sqlite3.ErrConstraintUnique
+}
+`
+	case strings.ToLower(config.Database) == "sqlite":
+		content += `	var sqliteErr *sqlite.Error
+	return errors.As(err, &sqliteErr) && sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_UNIQUE
+}
+`
+	default:
+		content += `	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
+`
+	}
+
+	return content
+}
+
+// generateUserRepository генерирует UserRepository с реализацией на выбранном слое доступа
+func (g *Generator) generateUserRepository(config *ProjectConfig) string {
+	kind := repositoryKind(config)
+	b := sqlBackendFor(config)
+	database := "db.(*database." + b.Name + "Database)"
+	tx := "*database." + b.Name + "Tx"
+
+	var std, imports []string
+	var field, value, conn string
+	read := "r.conn()"
+
+	switch {
+	case supportsReplicas(config) && kind == "gorm":
+		imports = []string{`"gorm.io/gorm"`}
+		field, value, read = "db *database."+b.Name+"Database", database, "r.read()"
+		conn = `
+// query возвращает запрос GORM для записи с контекстом запроса: внутри WithinTransaction - в его
+// транзакции, иначе в primary. Запись отмечается в контексте, и следующие чтения запроса идут в primary
+func (r *UserRepositoryImpl) query() *gorm.DB {
+	ctx := r.requestContext()
+	if tx, ok := database.TxFromContext(ctx).(` + tx + `); ok {
+		return tx.DB().WithContext(ctx)
+	}
+	database.MarkWritten(ctx)
+	return r.db.DB().WithContext(ctx)
+}
+
+// read возвращает запрос GORM для чтения: в транзакции, в primary после записи или в реплике
+func (r *UserRepositoryImpl) read() *gorm.DB {
+	ctx := r.requestContext()
+	if tx, ok := database.TxFromContext(ctx).(` + tx + `); ok {
+		return tx.DB().WithContext(ctx)
+	}
+	return r.db.Reader(ctx).WithContext(ctx)
+}
+`
+	case supportsReplicas(config) && kind == "sqlx":
+		std = []string{`"database/sql"`, `"time"`}
+		imports = []string{`"github.com/jmoiron/sqlx"`}
+		field, value, read = "db *database."+b.Name+"Database", database, "r.readConn()"
+		conn = replicaConnMethods("sqlx.ExtContext", tx, "tx.Tx()", "r.db.DB()", "r.db.Reader(ctx)")
+	case supportsReplicas(config) && kind == "pgx":
+		imports = []string{`"github.com/jackc/pgx/v5"`, `"github.com/jackc/pgx/v5/pgconn"`}
+		field, value, read = "db *database."+b.Name+"Database", database, "r.readConn()"
+		conn = replicaConnMethods("querier", tx, "tx.Tx()", "r.db.Pool()", "r.db.Reader(ctx)")
+	case supportsReplicas(config) && kind == "sqlc":
+		field, value, read = "db *database."+b.Name+"Database", database, "r.readConn()"
+		conn = replicaConnMethods("*queries.Queries", tx, "queries.New(tx.Tx())", "queries.New(r.db.DB())", "queries.New(r.db.Reader(ctx))")
+	case kind == "gorm":
+		imports = []string{`"gorm.io/gorm"`}
+		field, value, read = "db *gorm.DB", database+".DB()", "r.query()"
+		conn = `
+// query возвращает запрос GORM с контекстом запроса, внутри WithinTransaction - в его транзакции
+func (r *UserRepositoryImpl) query() *gorm.DB {
+	ctx := r.requestContext()
+	if tx, ok := database.TxFromContext(ctx).(` + tx + `); ok {
+		return tx.DB().WithContext(ctx)
+	}
+	return r.db.WithContext(ctx)
+}
+`
+	case kind == "sqlx":
+		std = []string{`"database/sql"`, `"time"`}
+		imports = []string{`"github.com/jmoiron/sqlx"`}
+		field, value = "db sqlx.ExtContext", database+".DB()"
+		conn = connMethod("sqlx.ExtContext", tx, "tx.Tx()", "r.db")
+	case kind == "pgx":
+		imports = []string{`"github.com/jackc/pgx/v5"`, `"github.com/jackc/pgx/v5/pgconn"`}
+		field, value = "db querier", database+".Pool()"
+		conn = connMethod("querier", tx, "tx.Tx()", "r.db")
+	case kind == "sqlc":
+		field, value = "queries *queries.Queries", "queries.New("+database+".DB())"
+		conn = connMethod("*queries.Queries", tx, "r.queries.WithTx(tx.Tx())", "r.queries")
+	case kind == "mongo":
+		std = []string{`"time"`}
+		imports = []string{`"go.mongodb.org/mongo-driver/bson"`, `"go.mongodb.org/mongo-driver/mongo"`, `"go.mongodb.org/mongo-driver/mongo/options"`, `"go.mongodb.org/mongo-driver/mongo/readpref"`}
+		field, value = "users *mongo.Collection", `db.(*database.MongoDatabase).Database().Collection("users")`
+	}
+	name := strings.Fields(field)[0]
+
+	content := `package repository
+
+import (` + sortedImports(append(std, `"context"`)...) + `
+`
+	if len(imports) > 0 {
+		content += sortedImports(imports...) + `
+`
+	}
+
+	content += `
+	"` + config.ModuleName + `/internal/models"`
+	if kind == "sqlc" {
+		content += `
+	"` + config.ModuleName + `/internal/repository/queries"`
+	}
+
+	content += `
+	"` + config.ModuleName + `/pkg/database"
+)
+
+// UserRepository интерфейс для работы с пользователями.
+// Отсутствующий пользователь - database.ErrNotFound, занятый email - database.ErrConflict
+type UserRepository interface {
+	database.Repository
+	GetByID(id int64) (*models.User, error)
+	GetByEmail(email string) (*models.User, error)
+	Create(user *models.User) error
+	Update(user *models.User) error
+	Delete(id int64) error
+	List(offset, limit int) ([]*models.User, error)
+	// WithContext возвращает репозиторий, выполняющий запросы с контекстом ctx,
+	// в том числе в транзакции WithinTransaction из этого контекста
+	WithContext(ctx context.Context) UserRepository
+	// WithTx возвращает репозиторий, выполняющий запросы в транзакции из BeginTx
+	WithTx(tx database.Tx) UserRepository
+}
+
+// UserRepositoryImpl реализация репозитория пользователей
+type UserRepositoryImpl struct {
+	*database.BaseRepository
+	` + field + `
+	// ctx контекст запросов, если репозиторий создан через WithContext или WithTx
+	ctx context.Context
+}
+
+// NewUserRepository создает новый репозиторий пользователей
+func NewUserRepository(db database.Database) UserRepository {
+	return &UserRepositoryImpl{
+		BaseRepository: database.NewBaseRepository(db),
+		` + name + `:` + strings.Repeat(" ", 15-len(name)) + value + `,
+	}
+}
+
+// WithContext возвращает копию репозитория, выполняющую запросы с контекстом ctx
+func (r *UserRepositoryImpl) WithContext(ctx context.Context) UserRepository {
+	repo := *r
+	base := *r.BaseRepository
+	repo.BaseRepository, repo.ctx = &base, ctx
+	return &repo
+}
+
+// WithTx возвращает копию репозитория, работающую в транзакции tx
+func (r *UserRepositoryImpl) WithTx(tx database.Tx) UserRepository {
+	return r.WithContext(tx.Context())
+}
+
+// requestContext возвращает контекст из WithContext, контекст запроса из AppContext или context.Background().
+// Транзакция WithinTransaction берется из этого контекста
+func (r *UserRepositoryImpl) requestContext() context.Context {
+	if r.ctx != nil {
+		return r.ctx
+	}
+	if appCtx := r.GetContext(); appCtx != nil && appCtx.Context() != nil {
+		return appCtx.Context()
+	}
+	return context.Background()
+}
+` + conn
+
+	switch kind {
+	case "gorm":
+		content += gormUserMethods(read)
+	case "sqlx":
+		content += sqlxUserMethods(b, read)
+	case "pgx":
+		content += pgxUserMethods(read)
+	case "sqlc":
+		content += sqlcUserMethods(b, read)
+	case "mongo":
+		content += mongoUserMethods()
+	}
+
+	return content
+}
+
+// replicaConnMethods генерирует conn для записи в primary и readConn для чтения из реплик
+func replicaConnMethods(typ, tx, txConn, primary, reader string) string {
+	return `
+// conn возвращает контекст запроса и подключение для записи: транзакцию WithinTransaction
+// из контекста или primary. Запись отмечается в контексте, и следующие чтения запроса идут в primary
+func (r *UserRepositoryImpl) conn() (context.Context, ` + typ + `) {
+	ctx := r.requestContext()
+	if tx, ok := database.TxFromContext(ctx).(` + tx + `); ok {
+		return ctx, ` + txConn + `
+	}
+	database.MarkWritten(ctx)
+	return ctx, ` + primary + `
+}
+
+// readConn возвращает контекст запроса и подключение для чтения: транзакцию, primary после записи или реплику
+func (r *UserRepositoryImpl) readConn() (context.Context, ` + typ + `) {
+	ctx := r.requestContext()
+	if tx, ok := database.TxFromContext(ctx).(` + tx + `); ok {
+		return ctx, ` + txConn + `
+	}
+	return ctx, ` + reader + `
+}
+`
+}
+
+// connMethod генерирует conn: контекст запроса и транзакция из него либо подключение по умолчанию
+func connMethod(typ, tx, txConn, conn string) string {
+	return `
+// conn возвращает контекст запроса и транзакцию WithinTransaction из него либо подключение к БД
+func (r *UserRepositoryImpl) conn() (context.Context, ` + typ + `) {
+	ctx := r.requestContext()
+	if tx, ok := database.TxFromContext(ctx).(` + tx + `); ok {
+		return ctx, ` + txConn + `
+	}
+	return ctx, ` + conn + `
+}
+`
+}
+
+// gormUserMethods методы UserRepositoryImpl на GORM, read - запрос для чтения
+func gormUserMethods(read string) string {
+	return `
+// GetByID получает пользователя по ID
+func (r *UserRepositoryImpl) GetByID(id int64) (*models.User, error) {
+	var user models.User
+	if err := ` + read + `.First(&user, id).Error; err != nil {
+		return nil, database.TranslateError(err)
+	}
+	return &user, nil
+}
+
+// GetByEmail получает пользователя по email
+func (r *UserRepositoryImpl) GetByEmail(email string) (*models.User, error) {
+	var user models.User
+	if err := ` + read + `.Where("email = ?", email).First(&user).Error; err != nil {
+		return nil, database.TranslateError(err)
+	}
+	return &user, nil
+}
+
+// Create создает нового пользователя и заполняет его ID
+func (r *UserRepositoryImpl) Create(user *models.User) error {
+	return database.TranslateError(r.query().Create(user).Error)
+}
+
+// Update обновляет email и имя пользователя
+func (r *UserRepositoryImpl) Update(user *models.User) error {
+	result := r.query().Model(user).Select("Email", "Name", "UpdatedAt").Updates(user)
+	if result.Error != nil {
+		return database.TranslateError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return database.ErrNotFound
+	}
+	return nil
+}
+
+// Delete удаляет пользователя
+func (r *UserRepositoryImpl) Delete(id int64) error {
+	result := r.query().Delete(&models.User{}, id)
+	if result.Error != nil {
+		return database.TranslateError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return database.ErrNotFound
+	}
+	return nil
+}
+
+// List возвращает список пользователей
+func (r *UserRepositoryImpl) List(offset, limit int) ([]*models.User, error) {
+	users := []*models.User{}
+	if err := ` + read + `.Order("id").Offset(offset).Limit(limit).Find(&users).Error; err != nil {
+		return nil, database.TranslateError(err)
+	}
+	return users, nil
+}
+`
+}
+
+// mongoUserMethods методы UserRepositoryImpl на коллекции users MongoDB
+func mongoUserMethods() string {
+	return `
+// countersCollection счетчики числовых ID, документ {_id: "users", seq: N}
+const countersCollection = "counters"
+
+// read возвращает контекст запроса и коллекцию для чтения: в транзакции и после записи в том же
+// запросе - с чтением из primary, иначе с database.read_preference подключения
+func (r *UserRepositoryImpl) read() (context.Context, *mongo.Collection) {
+	ctx := r.requestContext()
+	if database.ReadFromPrimary(ctx) {
+		return ctx, r.users.Database().Collection(r.users.Name(), options.Collection().SetReadPreference(readpref.Primary()))
+	}
+	return ctx, r.users
+}
+
+// write возвращает контекст запроса и отмечает в нем запись: следующие чтения запроса идут в primary
+func (r *UserRepositoryImpl) write() context.Context {
+	ctx := r.requestContext()
+	database.MarkWritten(ctx)
+	return ctx
+}
+
+// nextID выдает следующий ID пользователя
+func (r *UserRepositoryImpl) nextID(ctx context.Context) (int64, error) {
+	var counter struct {
+		Seq int64 ` + "`" + `bson:"seq"` + "`" + `
+	}
+
+	err := r.users.Database().Collection(countersCollection).FindOneAndUpdate(ctx,
+		bson.M{"_id": "users"},
+		bson.M{"$inc": bson.M{"seq": 1}},
+		options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After),
+	).Decode(&counter)
+	return counter.Seq, err
+}
+
+// GetByID получает пользователя по ID
+func (r *UserRepositoryImpl) GetByID(id int64) (*models.User, error) {
+	ctx, users := r.read()
+	var user models.User
+	if err := users.FindOne(ctx, bson.M{"_id": id}).Decode(&user); err != nil {
+		return nil, database.TranslateError(err)
+	}
+	return &user, nil
+}
+
+// GetByEmail получает пользователя по email
+func (r *UserRepositoryImpl) GetByEmail(email string) (*models.User, error) {
+	ctx, users := r.read()
+	var user models.User
+	if err := users.FindOne(ctx, bson.M{"email": email}).Decode(&user); err != nil {
+		return nil, database.TranslateError(err)
+	}
+	return &user, nil
+}
+
+// Create создает нового пользователя и заполняет его ID
+func (r *UserRepositoryImpl) Create(user *models.User) error {
+	ctx := r.write()
+
+	id, err := r.nextID(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	user.ID, user.CreatedAt, user.UpdatedAt = id, now, now
+	if _, err := r.users.InsertOne(ctx, user); err != nil {
+		user.ID = 0
+		return database.TranslateError(err)
+	}
+	return nil
+}
+
+// Update обновляет email и имя пользователя
+func (r *UserRepositoryImpl) Update(user *models.User) error {
+	user.UpdatedAt = time.Now().UTC()
+	result, err := r.users.UpdateOne(r.write(), bson.M{"_id": user.ID}, bson.M{"$set": bson.M{
+		"email":      user.Email,
+		"name":       user.Name,
+		"updated_at": user.UpdatedAt,
+	}})
+	if err != nil {
+		return database.TranslateError(err)
+	}
+	if result.MatchedCount == 0 {
+		return database.ErrNotFound
+	}
+	return nil
+}
+
+// Delete удаляет пользователя
+func (r *UserRepositoryImpl) Delete(id int64) error {
+	result, err := r.users.DeleteOne(r.write(), bson.M{"_id": id})
+	if err != nil {
+		return database.TranslateError(err)
+	}
+	if result.DeletedCount == 0 {
+		return database.ErrNotFound
+	}
+	return nil
+}
+
+// List возвращает список пользователей
+func (r *UserRepositoryImpl) List(offset, limit int) ([]*models.User, error) {
+	ctx, users := r.read()
+	opts := options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}).SetSkip(int64(offset)).SetLimit(int64(limit))
+
+	cursor, err := users.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, database.TranslateError(err)
+	}
+
+	result := []*models.User{}
+	if err := cursor.All(ctx, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+`
+}
+
+// generateUserRepositoryTest генерирует тест UserRepository на SQLite в памяти или во временном файле
+func (g *Generator) generateUserRepositoryTest(config *ProjectConfig) string {
+	return `package repository
+
+import (
+	"context"
+	"errors"` + sqliteTestImport(config) + `
+	"testing"
+
+	"` + config.ModuleName + `/internal/config"
+	"` + config.ModuleName + `/internal/models"
+	"` + config.ModuleName + `/pkg/database"
+)
+
+func newTestRepository(t *testing.T) (UserRepository, database.Database) {
+	t.Helper()
+
+	cfg := &config.Config{}
+	cfg.Database = ` + sqliteTestDatabase(config) + `
+
+	db, err := database.New(cfg)
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	if err := db.Migrate(); err != nil {
+		t.Fatalf("Migrate failed: %v", err)
+	}
+	return NewUserRepository(db), db
+}
+
+func TestUserRepositoryCRUD(t *testing.T) {
+	repo, _ := newTestRepository(t)
+
+	user := &models.User{Email: "a@example.com", Name: "A"}
+	if err := repo.Create(user); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if user.ID == 0 || user.CreatedAt.IsZero() {
+		t.Fatalf("Create must fill ID and CreatedAt: %+v", user)
+	}
+
+	got, err := repo.GetByEmail("a@example.com")
+	if err != nil || got.ID != user.ID {
+		t.Fatalf("GetByEmail = %+v, %v", got, err)
+	}
+
+	user.Name = "B"
+	if err := repo.Update(user); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	if got, err = repo.GetByID(user.ID); err != nil || got.Name != "B" {
+		t.Fatalf("GetByID after update = %+v, %v", got, err)
+	}
+
+	users, err := repo.List(0, 10)
+	if err != nil || len(users) != 1 {
+		t.Fatalf("List = %v, %v", users, err)
+	}
+
+	if err := repo.Delete(user.ID); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if _, err := repo.GetByID(user.ID); !errors.Is(err, database.ErrNotFound) {
+		t.Errorf("GetByID after delete: expected ErrNotFound, got %v", err)
+	}
+	if err := repo.Delete(user.ID); !errors.Is(err, database.ErrNotFound) {
+		t.Errorf("second Delete: expected ErrNotFound, got %v", err)
+	}
+	if err := repo.Update(user); !errors.Is(err, database.ErrNotFound) {
+		t.Errorf("Update of deleted user: expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestUserRepositoryConflict(t *testing.T) {
+	repo, _ := newTestRepository(t)
+
+	if err := repo.Create(&models.User{Email: "a@example.com", Name: "A"}); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if err := repo.Create(&models.User{Email: "a@example.com", Name: "B"}); !errors.Is(err, database.ErrConflict) {
+		t.Errorf("expected ErrConflict for duplicate email, got %v", err)
+	}
+}
+
+func TestUserRepositoryWithTx(t *testing.T) {
+	repo, db := newTestRepository(t)
+
+	tx, err := db.BeginTx(context.Background())
+	if err != nil {
+		t.Fatalf("BeginTx failed: %v", err)
+	}
+	if err := repo.WithTx(tx).Create(&models.User{Email: "tx@example.com", Name: "T"}); err != nil {
+		t.Fatalf("Create in tx failed: %v", err)
+	}
+	if err := tx.Rollback(); err != nil {
+		t.Fatalf("Rollback failed: %v", err)
+	}
+
+	if _, err := repo.GetByEmail("tx@example.com"); !errors.Is(err, database.ErrNotFound) {
+		t.Errorf("user created in rolled back tx must not exist, got %v", err)
+	}
+}
+
+func TestWithinTransaction(t *testing.T) {
+	repo, db := newTestRepository(t)
+	ctx := context.Background()
+
+	err := db.WithinTransaction(ctx, func(ctx context.Context) error {
+		return repo.WithContext(ctx).Create(&models.User{Email: "commit@example.com", Name: "C"})
+	})
+	if err != nil {
+		t.Fatalf("WithinTransaction failed: %v", err)
+	}
+	if _, err := repo.GetByEmail("commit@example.com"); err != nil {
+		t.Errorf("committed user not found: %v", err)
+	}
+
+	errFailed := errors.New("failed")
+	err = db.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := repo.WithContext(ctx).Create(&models.User{Email: "rollback@example.com", Name: "R"}); err != nil {
+			return err
+		}
+		return errFailed
+	})
+	if !errors.Is(err, errFailed) {
+		t.Fatalf("expected fn error, got %v", err)
+	}
+	if _, err := repo.GetByEmail("rollback@example.com"); !errors.Is(err, database.ErrNotFound) {
+		t.Errorf("user from failed transaction must not exist, got %v", err)
+	}
+}
+
+func TestWithinTransactionPanic(t *testing.T) {
+	repo, db := newTestRepository(t)
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Fatal("panic must be rethrown")
+			}
+		}()
+		_ = db.WithinTransaction(context.Background(), func(ctx context.Context) error {
+			if err := repo.WithContext(ctx).Create(&models.User{Email: "panic@example.com", Name: "P"}); err != nil {
+				return err
+			}
+			panic("boom")
+		})
+	}()
+
+	if _, err := repo.GetByEmail("panic@example.com"); !errors.Is(err, database.ErrNotFound) {
+		t.Errorf("user from panicked transaction must not exist, got %v", err)
+	}
+}
+
+func TestWithinTransactionNested(t *testing.T) {
+	repo, db := newTestRepository(t)
+
+	err := db.WithinTransaction(context.Background(), func(ctx context.Context) error {
+		if err := repo.WithContext(ctx).Create(&models.User{Email: "outer@example.com", Name: "O"}); err != nil {
+			return err
+		}
+
+		// Ошибка вложенного вызова откатывает только его точку сохранения
+		nested := db.WithinTransaction(ctx, func(ctx context.Context) error {
+			if err := repo.WithContext(ctx).Create(&models.User{Email: "inner@example.com", Name: "I"}); err != nil {
+				return err
+			}
+			return repo.WithContext(ctx).Create(&models.User{Email: "outer@example.com", Name: "D"})
+		})
+		if !errors.Is(nested, database.ErrConflict) {
+			t.Errorf("expected ErrConflict from nested call, got %v", nested)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("WithinTransaction failed: %v", err)
+	}
+
+	if _, err := repo.GetByEmail("outer@example.com"); err != nil {
+		t.Errorf("outer user not found: %v", err)
+	}
+	if _, err := repo.GetByEmail("inner@example.com"); !errors.Is(err, database.ErrNotFound) {
+		t.Errorf("inner user must be rolled back to savepoint, got %v", err)
+	}
+}
+`
+}
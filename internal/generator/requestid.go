@@ -0,0 +1,333 @@
+package generator
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// generateRequestID создает пакет pkg/requestid и middleware для X-Request-ID
+func (g *Generator) generateRequestID(config *ProjectConfig) error {
+	requestIDDir := filepath.Join(g.projectPath, "pkg/requestid")
+	if err := os.MkdirAll(requestIDDir, 0755); err != nil {
+		return err
+	}
+
+	files := map[string]string{
+		"requestid.go":      g.generateRequestIDPackage(),
+		"requestid_test.go": g.generateRequestIDTest(),
+	}
+
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(requestIDDir, name), []byte(content), 0644); err != nil {
+			return err
+		}
+	}
+
+	return g.generateRequestIDMiddleware(config)
+}
+
+// generateRequestIDPackage генерирует хранение request ID в контексте и проброс в HTTP клиент
+func (g *Generator) generateRequestIDPackage() string {
+	return `package requestid
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+)
+
+const (
+	// Header HTTP заголовок с request ID
+	Header = "X-Request-ID"
+	// MetadataKey ключ gRPC метаданных с request ID
+	MetadataKey = "x-request-id"
+
+	maxLength = 128
+)
+
+// contextKey ключ для хранения request ID в context.Context
+type contextKey struct{}
+
+// New генерирует новый request ID
+func New() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(b)
+}
+
+// Sanitize возвращает входящий request ID, если он допустим, иначе пустую строку
+func Sanitize(id string) string {
+	if id == "" || len(id) > maxLength {
+		return ""
+	}
+	for _, r := range id {
+		if r < 0x21 || r > 0x7e {
+			return ""
+		}
+	}
+	return id
+}
+
+// NewContext сохраняет request ID в контексте
+func NewContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext возвращает request ID из контекста
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(contextKey{}).(string)
+	return id
+}
+
+// Transport пробрасывает request ID из контекста запроса в исходящие HTTP вызовы
+type Transport struct {
+	Base http.RoundTripper
+}
+
+// NewTransport оборачивает base (nil - http.DefaultTransport)
+func NewTransport(base http.RoundTripper) *Transport {
+	return &Transport{Base: base}
+}
+
+// RoundTrip добавляет заголовок X-Request-ID, если он еще не задан
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	if id := FromContext(req.Context()); id != "" && req.Header.Get(Header) == "" {
+		req = req.Clone(req.Context())
+		req.Header.Set(Header, id)
+	}
+
+	return base.RoundTrip(req)
+}
+`
+}
+
+// generateRequestIDTest генерирует тесты пакета requestid
+func (g *Generator) generateRequestIDTest() string {
+	return `package requestid
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestSanitize(t *testing.T) {
+	cases := map[string]string{
+		"abc-123":                "abc-123",
+		"":                       "",
+		"with space":             "",
+		"line\nbreak":            "",
+		strings.Repeat("a", 129): "",
+	}
+	for input, expected := range cases {
+		if got := Sanitize(input); got != expected {
+			t.Errorf("Sanitize(%q) = %q, want %q", input, got, expected)
+		}
+	}
+
+	if id := New(); Sanitize(id) != id || len(id) != 32 {
+		t.Errorf("generated id %q is not valid", id)
+	}
+}
+
+func TestTransportForwardsRequestID(t *testing.T) {
+	var received string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Get(Header)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: NewTransport(nil)}
+	req, _ := http.NewRequestWithContext(NewContext(context.Background(), "req-1"), http.MethodGet, server.URL, nil)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	resp.Body.Close()
+
+	if received != "req-1" {
+		t.Errorf("expected forwarded request id req-1, got %q", received)
+	}
+}
+`
+}
+
+// requestIDTracingImport возвращает импорт pkg/tracing, если включен --otel
+func requestIDTracingImport(config *ProjectConfig) string {
+	if !config.EnableOTel {
+		return ""
+	}
+	return `
+	"` + config.ModuleName + `/pkg/tracing"`
+}
+
+// generateRequestIDMiddleware создает internal/middleware/requestid.go для выбранного фреймворка
+func (g *Generator) generateRequestIDMiddleware(config *ProjectConfig) error {
+	var content string
+
+	switch strings.ToLower(config.Framework) {
+	case "fiber":
+		content = fmt.Sprintf(`package middleware
+
+import (
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+
+	"%s/internal/config"
+	appcontext "%s/pkg/context"
+	"%s/pkg/logger"
+	"%s/pkg/requestid"`+requestIDTracingImport(config)+`
+)
+
+// RequestIDMiddleware читает или создает X-Request-ID и сохраняет его в AppContext
+func RequestIDMiddleware(log logger.Logger, cfg config.RequestIDConfig) fiber.Handler {
+	header := requestIDHeader(cfg)
+
+	return func(c *fiber.Ctx) error {
+		id := requestid.Sanitize(c.Get(header))
+		if id == "" {
+			id = newRequestID(c.UserContext())
+		}
+
+		c.Set(header, id)
+		c.SetUserContext(withRequestID(c.UserContext(), log, id))
+		return c.Next()
+	}
+}
+`, config.ModuleName, config.ModuleName, config.ModuleName, config.ModuleName)
+	case "echo":
+		content = fmt.Sprintf(`package middleware
+
+import (
+	"context"
+
+	"github.com/labstack/echo/v4"
+
+	"%s/internal/config"
+	appcontext "%s/pkg/context"
+	"%s/pkg/logger"
+	"%s/pkg/requestid"`+requestIDTracingImport(config)+`
+)
+
+// RequestIDMiddleware читает или создает X-Request-ID и сохраняет его в AppContext
+func RequestIDMiddleware(log logger.Logger, cfg config.RequestIDConfig) echo.MiddlewareFunc {
+	header := requestIDHeader(cfg)
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			id := requestid.Sanitize(req.Header.Get(header))
+			if id == "" {
+				id = newRequestID(req.Context())
+			}
+
+			c.Response().Header().Set(header, id)
+			c.SetRequest(req.WithContext(withRequestID(req.Context(), log, id)))
+			return next(c)
+		}
+	}
+}
+`, config.ModuleName, config.ModuleName, config.ModuleName, config.ModuleName)
+	default:
+		content = fmt.Sprintf(`package middleware
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+
+	"%s/internal/config"
+	appcontext "%s/pkg/context"
+	"%s/pkg/logger"
+	"%s/pkg/requestid"`+requestIDTracingImport(config)+`
+)
+
+// RequestIDMiddleware читает или создает X-Request-ID и сохраняет его в AppContext
+func RequestIDMiddleware(log logger.Logger, cfg config.RequestIDConfig) gin.HandlerFunc {
+	header := requestIDHeader(cfg)
+
+	return func(c *gin.Context) {
+		id := requestid.Sanitize(c.GetHeader(header))
+		if id == "" {
+			id = newRequestID(c.Request.Context())
+		}
+
+		c.Header(header, id)
+		c.Request = c.Request.WithContext(withRequestID(c.Request.Context(), log, id))
+		c.Next()
+	}
+}
+`, config.ModuleName, config.ModuleName, config.ModuleName, config.ModuleName)
+	}
+
+	if config.EnableOTel {
+		content += `
+// newRequestID использует trace ID текущего спана, чтобы request ID совпадал с трассой
+func newRequestID(ctx context.Context) string {
+	if id := tracing.TraceID(ctx); id != "" {
+		return id
+	}
+	return requestid.New()
+}
+
+// withRequestID сохраняет request ID в контексте, AppContext и атрибутах спана
+func withRequestID(ctx context.Context, log logger.Logger, id string) context.Context {
+	ctx = requestid.NewContext(ctx, id)
+	tracing.AnnotateRequestID(ctx, id)
+
+	appCtx, ok := appcontext.FromContext(ctx)
+	if !ok {
+		appCtx = appcontext.New(ctx, log.WithContext(ctx))
+	}
+
+	return appcontext.ToContext(ctx, appCtx.WithTraceID(id))
+}
+`
+	} else {
+		content += `
+// newRequestID генерирует новый request ID
+func newRequestID(_ context.Context) string {
+	return requestid.New()
+}
+
+// withRequestID сохраняет request ID в контексте и AppContext
+func withRequestID(ctx context.Context, log logger.Logger, id string) context.Context {
+	ctx = requestid.NewContext(ctx, id)
+
+	appCtx, ok := appcontext.FromContext(ctx)
+	if !ok {
+		appCtx = appcontext.New(ctx, log)
+	}
+
+	return appcontext.ToContext(ctx, appCtx.WithTraceID(id))
+}
+`
+	}
+
+	content += `
+// requestIDHeader возвращает заголовок с request ID
+func requestIDHeader(cfg config.RequestIDConfig) string {
+	if cfg.Header != "" {
+		return cfg.Header
+	}
+	return requestid.Header
+}
+`
+
+	requestIDPath := filepath.Join(g.projectPath, "internal/middleware/requestid.go")
+	return os.WriteFile(requestIDPath, []byte(content), 0644)
+}
@@ -0,0 +1,307 @@
+package generator
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// randomSecret возвращает случайную hex строку из n байт для локальных учетных данных
+func randomSecret(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// hasPasswordDatabase проверяет, использует ли выбранная БД пароль
+func hasPasswordDatabase(config *ProjectConfig) bool {
+	switch strings.ToLower(config.Database) {
+	case "postgresql", "mysql", "clickhouse":
+		return true
+	default:
+		return false
+	}
+}
+
+// generateSecrets создает internal/config/secrets.go, .env со случайными учетными данными и .gitignore
+func (g *Generator) generateSecrets(config *ProjectConfig) error {
+	files := map[string]string{
+		"secrets.go":      g.generateSecretProvider(),
+		"secrets_test.go": g.generateSecretProviderTest(),
+	}
+
+	for name, content := range files {
+		path := filepath.Join(g.projectPath, "internal/config", name)
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			return err
+		}
+	}
+
+	if err := g.generateDotEnv(config); err != nil {
+		return err
+	}
+
+	return g.generateGitIgnore(config)
+}
+
+// generateSecretProvider генерирует подстановку секретов из внешнего хранилища
+func (g *Generator) generateSecretProvider() string {
+	return `package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+)
+
+// SecretPrefix отмечает значение, которое нужно получить из SecretProvider: password: "secret:db-password"
+const SecretPrefix = "secret:"
+
+// DefaultSecretsDir каталог секретов Docker и Kubernetes по умолчанию
+const DefaultSecretsDir = "/run/secrets"
+
+// ErrSecretNotFound возвращается провайдером, если секрета нет
+var ErrSecretNotFound = errors.New("секрет не найден")
+
+// SecretProvider источник секретов. Реализуйте его для Vault, AWS Secrets Manager и т.п.
+type SecretProvider interface {
+	Secret(ctx context.Context, name string) (string, error)
+}
+
+// FileProvider читает секрет из файла <Dir>/<name>, например /run/secrets/db-password
+type FileProvider struct {
+	Dir string
+}
+
+// Secret возвращает содержимое файла без завершающего перевода строки
+func (p FileProvider) Secret(_ context.Context, name string) (string, error) {
+	if name == "" || strings.Contains(name, "..") {
+		return "", fmt.Errorf("некорректное имя секрета %q", name)
+	}
+
+	data, err := os.ReadFile(filepath.Join(p.Dir, name))
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", ErrSecretNotFound
+	}
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
+
+// Option настраивает загрузку конфигурации
+type Option func(*loadOptions)
+
+type loadOptions struct {
+	secrets SecretProvider
+}
+
+func defaultLoadOptions() *loadOptions {
+	return &loadOptions{secrets: FileProvider{Dir: DefaultSecretsDir}}
+}
+
+// WithSecretProvider задает источник значений "secret:<имя>"
+func WithSecretProvider(provider SecretProvider) Option {
+	return func(o *loadOptions) {
+		o.secrets = provider
+	}
+}
+
+// resolveSecrets заменяет строковые поля вида "secret:<имя>" значениями из provider
+func resolveSecrets(ctx context.Context, target interface{}, provider SecretProvider) error {
+	return resolveSecretsStruct(ctx, reflect.ValueOf(target).Elem(), "", provider)
+}
+
+func resolveSecretsStruct(ctx context.Context, v reflect.Value, path string, provider SecretProvider) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("yaml"), ",")[0]
+		if name == "" || name == "-" {
+			continue
+		}
+
+		field := v.Field(i)
+		fieldPath := strings.TrimPrefix(path+"."+name, ".")
+
+		switch field.Kind() {
+		case reflect.Struct:
+			if err := resolveSecretsStruct(ctx, field, fieldPath, provider); err != nil {
+				return err
+			}
+		case reflect.Map:
+			// Именованные секции, например datasources: значения map неадресуемы, поэтому копируются
+			if field.Type().Elem().Kind() != reflect.Struct {
+				continue
+			}
+			iter := field.MapRange()
+			for iter.Next() {
+				elem := reflect.New(field.Type().Elem()).Elem()
+				elem.Set(iter.Value())
+				if err := resolveSecretsStruct(ctx, elem, fieldPath+"."+iter.Key().String(), provider); err != nil {
+					return err
+				}
+				field.SetMapIndex(iter.Key(), elem)
+			}
+		case reflect.String:
+			ref, ok := strings.CutPrefix(field.String(), SecretPrefix)
+			if !ok {
+				continue
+			}
+			if provider == nil {
+				return fmt.Errorf("%s: источник секретов не настроен", fieldPath)
+			}
+			value, err := provider.Secret(ctx, ref)
+			if err != nil {
+				return fmt.Errorf("%s: секрет %q: %w", fieldPath, ref, err)
+			}
+			field.SetString(value)
+		}
+	}
+	return nil
+}
+`
+}
+
+// generateSecretProviderTest генерирует тесты секретов
+func (g *Generator) generateSecretProviderTest() string {
+	return `package config
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// mapProvider секреты из map для тестов
+type mapProvider map[string]string
+
+func (p mapProvider) Secret(_ context.Context, name string) (string, error) {
+	value, ok := p[name]
+	if !ok {
+		return "", ErrSecretNotFound
+	}
+	return value, nil
+}
+
+func TestResolveSecrets(t *testing.T) {
+	cfg := &Config{}
+	cfg.Database.Password = "secret:db-password"
+	cfg.Database.User = "app"
+
+	if err := resolveSecrets(context.Background(), cfg, mapProvider{"db-password": "s3cr3t"}); err != nil {
+		t.Fatalf("resolveSecrets failed: %v", err)
+	}
+	if cfg.Database.Password != "s3cr3t" || cfg.Database.User != "app" {
+		t.Errorf("unexpected database config: %+v", cfg.Database)
+	}
+
+	cfg.Middleware.RateLimit.Redis.Password = "secret:missing"
+	err := resolveSecrets(context.Background(), cfg, mapProvider{})
+	if !errors.Is(err, ErrSecretNotFound) {
+		t.Errorf("expected ErrSecretNotFound, got %v", err)
+	}
+}
+
+func TestFileProviderAndEnvFile(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "db-password"), []byte("from-file\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	value, err := FileProvider{Dir: dir}.Secret(context.Background(), "db-password")
+	if err != nil || value != "from-file" {
+		t.Errorf("FileProvider.Secret = %q, %v", value, err)
+	}
+
+	t.Setenv("APP_DATABASE_PASSWORD_FILE", filepath.Join(dir, "db-password"))
+	cfg := &Config{}
+	if err := applyEnv(cfg, EnvPrefix); err != nil {
+		t.Fatalf("applyEnv failed: %v", err)
+	}
+	if cfg.Database.Password != "from-file" {
+		t.Errorf("expected password from APP_DATABASE_PASSWORD_FILE, got %q", cfg.Database.Password)
+	}
+
+	t.Setenv("APP_DATABASE_PASSWORD", "from-env")
+	if err := applyEnv(&Config{}, EnvPrefix); err == nil {
+		t.Error("expected error when both APP_DATABASE_PASSWORD and APP_DATABASE_PASSWORD_FILE are set")
+	}
+}
+`
+}
+
+// generateDotEnv создает git-ignored .env со случайными учетными данными для локальной разработки.
+// docker-compose берет из него пароль БД, приложение - через config.Load
+func (g *Generator) generateDotEnv(config *ProjectConfig) error {
+	content := `# Локальные учетные данные, сгенерированы project-initializer. Не коммитьте этот файл
+APP_ENV=development
+`
+
+	secrets := []string{"APP_ADMIN_TOKEN"}
+	if hasPasswordDatabase(config) {
+		secrets = append(secrets, "APP_DATABASE_PASSWORD")
+	}
+	if config.EnableAuth {
+		secrets = append(secrets, "APP_AUTH_SECRET")
+	}
+
+	for _, name := range secrets {
+		value, err := randomSecret(16)
+		if err != nil {
+			return err
+		}
+		content += name + "=" + value + "\n"
+	}
+
+	envPath := filepath.Join(g.projectPath, ".env")
+	return os.WriteFile(envPath, []byte(content), 0600)
+}
+
+// generateGitIgnore создает .gitignore, в который входит .env с секретами
+func (g *Generator) generateGitIgnore(config *ProjectConfig) error {
+	content := `# Секреты и локальное окружение
+.env
+.env.local
+*.pem
+*.key
+certs/
+
+# Сборка
+/main
+*.exe
+dist/
+build/
+
+# Логи и тесты
+logs/
+*.log
+coverage.out
+
+# IDE
+.vscode/
+.idea/
+.DS_Store
+`
+
+	// Файл SQLite и журналы WAL создаются при запуске в data/
+	if strings.ToLower(config.Database) == "sqlite" {
+		content += `
+# База данных SQLite
+data/
+`
+	}
+
+	gitIgnorePath := filepath.Join(g.projectPath, ".gitignore")
+	return os.WriteFile(gitIgnorePath, []byte(content), 0644)
+}
@@ -0,0 +1,260 @@
+package generator
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// generateTLS создает пакет pkg/tlsutil для TLS/mTLS
+func (g *Generator) generateTLS(config *ProjectConfig) error {
+	content := fmt.Sprintf(`package tlsutil
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"%s/internal/config"
+	"%s/pkg/logger"
+)
+
+// Reloader загружает TLS сертификаты и перечитывает их при изменении файлов
+type Reloader struct {
+	cfg        config.TLSConfig
+	logger     logger.Logger
+	minVersion uint16
+
+	mu        sync.RWMutex
+	cert      *tls.Certificate
+	clientCAs *x509.CertPool
+	modTimes  map[string]time.Time
+}
+
+// NewReloader создает Reloader и загружает сертификаты
+func NewReloader(cfg config.TLSConfig, logger logger.Logger) (*Reloader, error) {
+	minVersion, err := ParseVersion(cfg.MinVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Reloader{
+		cfg:        cfg,
+		logger:     logger,
+		minVersion: minVersion,
+		modTimes:   make(map[string]time.Time),
+	}
+
+	if err := r.reload(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// ServerConfig возвращает tls.Config для сервера с актуальными сертификатами
+func (r *Reloader) ServerConfig() *tls.Config {
+	cfg := &tls.Config{
+		MinVersion:     r.minVersion,
+		GetCertificate: r.getCertificate,
+	}
+
+	// mTLS: клиентский сертификат проверяется по актуальному CA
+	if r.cfg.ClientCAFile != "" {
+		cfg.ClientAuth = tls.RequireAnyClientCert
+		cfg.VerifyPeerCertificate = r.verifyClientCertificate
+	}
+
+	return cfg
+}
+
+// Watch периодически проверяет файлы сертификатов и перечитывает их при изменении
+func (r *Reloader) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if !r.changed() {
+				continue
+			}
+			if err := r.reload(); err != nil {
+				r.logger.Error("Ошибка перезагрузки TLS сертификатов", "error", err)
+				continue
+			}
+			r.logger.Info("TLS сертификаты перезагружены", "cert_file", r.cfg.CertFile)
+		}
+	}
+}
+
+// reload читает сертификат, ключ и CA с диска
+func (r *Reloader) reload() error {
+	cert, err := tls.LoadX509KeyPair(r.cfg.CertFile, r.cfg.KeyFile)
+	if err != nil {
+		return fmt.Errorf("ошибка загрузки сертификата: %%w", err)
+	}
+
+	var clientCAs *x509.CertPool
+	if r.cfg.ClientCAFile != "" {
+		clientCAs, err = LoadCertPool(r.cfg.ClientCAFile)
+		if err != nil {
+			return err
+		}
+	}
+
+	modTimes := make(map[string]time.Time)
+	for _, file := range r.files() {
+		if info, err := os.Stat(file); err == nil {
+			modTimes[file] = info.ModTime()
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cert = &cert
+	r.clientCAs = clientCAs
+	r.modTimes = modTimes
+
+	return nil
+}
+
+// changed проверяет, изменились ли файлы с момента последней загрузки
+func (r *Reloader) changed() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, file := range r.files() {
+		info, err := os.Stat(file)
+		if err != nil {
+			continue
+		}
+		if !info.ModTime().Equal(r.modTimes[file]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// files возвращает список отслеживаемых файлов
+func (r *Reloader) files() []string {
+	files := []string{r.cfg.CertFile, r.cfg.KeyFile}
+	if r.cfg.ClientCAFile != "" {
+		files = append(files, r.cfg.ClientCAFile)
+	}
+	return files
+}
+
+// getCertificate возвращает текущий сертификат сервера
+func (r *Reloader) getCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert, nil
+}
+
+// verifyClientCertificate проверяет цепочку клиентского сертификата по текущему CA
+func (r *Reloader) verifyClientCertificate(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+	if len(rawCerts) == 0 {
+		return fmt.Errorf("клиентский сертификат не предоставлен")
+	}
+
+	certs := make([]*x509.Certificate, 0, len(rawCerts))
+	for _, raw := range rawCerts {
+		cert, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return fmt.Errorf("ошибка разбора клиентского сертификата: %%w", err)
+		}
+		certs = append(certs, cert)
+	}
+
+	r.mu.RLock()
+	roots := r.clientCAs
+	r.mu.RUnlock()
+
+	opts := x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: x509.NewCertPool(),
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	for _, cert := range certs[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+
+	if _, err := certs[0].Verify(opts); err != nil {
+		return fmt.Errorf("клиентский сертификат не прошел проверку: %%w", err)
+	}
+
+	return nil
+}
+
+// NewClientConfig создает tls.Config для клиента.
+// caFile используется для проверки сервера, certFile/keyFile - для mTLS (опционально)
+func NewClientConfig(caFile, certFile, keyFile, serverName string) (*tls.Config, error) {
+	cfg := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: serverName,
+	}
+
+	if caFile != "" {
+		pool, err := LoadCertPool(caFile)
+		if err != nil {
+			return nil, err
+		}
+		cfg.RootCAs = pool
+	}
+
+	if certFile != "" && keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("ошибка загрузки клиентского сертификата: %%w", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+
+	return cfg, nil
+}
+
+// LoadCertPool загружает пул сертификатов из PEM файла
+func LoadCertPool(file string) (*x509.CertPool, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка чтения CA: %%w", err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("в файле %%s не найдено PEM сертификатов", file)
+	}
+
+	return pool, nil
+}
+
+// ParseVersion преобразует строку версии TLS ("1.2", "1.3") в константу crypto/tls
+func ParseVersion(version string) (uint16, error) {
+	switch version {
+	case "", "1.2":
+		return tls.VersionTLS12, nil
+	case "1.3":
+		return tls.VersionTLS13, nil
+	default:
+		return 0, fmt.Errorf("неподдерживаемая версия TLS: %%s", version)
+	}
+}
+`, config.ModuleName, config.ModuleName)
+
+	tlsDir := filepath.Join(g.projectPath, "pkg/tlsutil")
+	if err := os.MkdirAll(tlsDir, 0755); err != nil {
+		return err
+	}
+
+	tlsPath := filepath.Join(tlsDir, "tlsutil.go")
+	return os.WriteFile(tlsPath, []byte(content), 0644)
+}
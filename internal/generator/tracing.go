@@ -0,0 +1,332 @@
+package generator
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// generateTracing создает пакет pkg/tracing и HTTP middleware трассировки
+func (g *Generator) generateTracing(config *ProjectConfig) error {
+	tracingDir := filepath.Join(g.projectPath, "pkg/tracing")
+	if err := os.MkdirAll(tracingDir, 0755); err != nil {
+		return err
+	}
+
+	tracingPath := filepath.Join(tracingDir, "tracing.go")
+	if err := os.WriteFile(tracingPath, []byte(g.generateTracingProvider(config)), 0644); err != nil {
+		return err
+	}
+
+	return g.generateTracingMiddleware(config)
+}
+
+// generateTracingProvider генерирует инициализацию OpenTelemetry TracerProvider
+func (g *Generator) generateTracingProvider(config *ProjectConfig) string {
+	return fmt.Sprintf(`package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+
+	"%s/internal/config"
+)
+
+// TracerName имя tracer для спанов сервиса
+const TracerName = "%s"
+
+// ShutdownFunc завершает работу TracerProvider и отправляет оставшиеся спаны
+type ShutdownFunc func(ctx context.Context) error
+
+// Init настраивает глобальные TracerProvider и propagator.
+// При tracing.enabled: false спаны не создаются, но W3C trace context пробрасывается дальше
+func Init(ctx context.Context, cfg config.TracingConfig, app config.AppConfig) (ShutdownFunc, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	if !cfg.Enabled {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	res, err := resource.New(ctx,
+		resource.WithFromEnv(),
+		resource.WithAttributes(
+			attribute.String("service.name", app.Name),
+			attribute.String("service.version", app.Version),
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка создания resource: %%w", err)
+	}
+
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	}
+
+	exporter, err := newExporter(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	if exporter != nil {
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+	}
+
+	provider := sdktrace.NewTracerProvider(opts...)
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+// newExporter создает exporter по настройке exporter: otlp, stdout или none
+func newExporter(ctx context.Context, cfg config.TracingConfig) (sdktrace.SpanExporter, error) {
+	switch cfg.Exporter {
+	case "", "otlp":
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.Endpoint)}
+		if cfg.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		exporter, err := otlptracegrpc.New(ctx, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("ошибка создания OTLP exporter: %%w", err)
+		}
+		return exporter, nil
+	case "stdout":
+		exporter, err := stdouttrace.New(stdouttrace.WithPrettyPrint())
+		if err != nil {
+			return nil, fmt.Errorf("ошибка создания stdout exporter: %%w", err)
+		}
+		return exporter, nil
+	case "none":
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("неизвестный exporter трассировки: %%s", cfg.Exporter)
+	}
+}
+
+// TraceID возвращает trace ID текущего спана или пустую строку
+func TraceID(ctx context.Context) string {
+	if sc := trace.SpanContextFromContext(ctx); sc.HasTraceID() {
+		return sc.TraceID().String()
+	}
+	return ""
+}
+
+// AnnotateRequestID связывает текущий спан с request ID из AppContext
+func AnnotateRequestID(ctx context.Context, id string) {
+	trace.SpanFromContext(ctx).SetAttributes(attribute.String("request.id", id))
+}
+`, config.ModuleName, config.ModuleName)
+}
+
+// generateTracingMiddleware создает internal/middleware/tracing.go для выбранного фреймворка
+func (g *Generator) generateTracingMiddleware(config *ProjectConfig) error {
+	var content string
+
+	switch strings.ToLower(config.Framework) {
+	case "fiber":
+		content = fmt.Sprintf(`package middleware
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"%s/pkg/tracing"
+)
+
+// TracingMiddleware создает серверный спан для каждого HTTP запроса
+func TracingMiddleware() fiber.Handler {
+	tracer := otel.Tracer(tracing.TracerName)
+
+	return func(c *fiber.Ctx) error {
+		ctx := otel.GetTextMapPropagator().Extract(c.UserContext(), fiberCarrier{c})
+		ctx, span := tracer.Start(ctx, c.Method()+" "+c.Path(),
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				attribute.String("http.request.method", c.Method()),
+				attribute.String("url.path", c.Path()),
+			),
+		)
+		defer span.End()
+
+		c.SetUserContext(ctx)
+		err := c.Next()
+
+		status := c.Response().StatusCode()
+		if err != nil {
+			span.RecordError(err)
+			status = fiber.StatusInternalServerError
+			var fiberErr *fiber.Error
+			if errors.As(err, &fiberErr) {
+				status = fiberErr.Code
+			}
+		}
+
+		route := c.Route().Path
+		span.SetName(c.Method() + " " + route)
+		finishSpan(span, route, status)
+
+		return err
+	}
+}
+
+// fiberCarrier адаптирует заголовки запроса Fiber для propagator
+type fiberCarrier struct {
+	c *fiber.Ctx
+}
+
+// Get возвращает значение заголовка
+func (f fiberCarrier) Get(key string) string {
+	return f.c.Get(key)
+}
+
+// Set устанавливает заголовок
+func (f fiberCarrier) Set(key, value string) {
+	f.c.Request().Header.Set(key, value)
+}
+
+// Keys возвращает имена заголовков
+func (f fiberCarrier) Keys() []string {
+	var keys []string
+	f.c.Request().Header.VisitAll(func(key, _ []byte) {
+		keys = append(keys, string(key))
+	})
+	return keys
+}
+`, config.ModuleName)
+	case "echo":
+		content = fmt.Sprintf(`package middleware
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	"%s/pkg/tracing"
+)
+
+// TracingMiddleware создает серверный спан для каждого HTTP запроса
+func TracingMiddleware() echo.MiddlewareFunc {
+	tracer := otel.Tracer(tracing.TracerName)
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			route := c.Path()
+			if route == "" {
+				route = req.URL.Path
+			}
+
+			ctx := otel.GetTextMapPropagator().Extract(req.Context(), propagation.HeaderCarrier(req.Header))
+			ctx, span := tracer.Start(ctx, req.Method+" "+route,
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(
+					attribute.String("http.request.method", req.Method),
+					attribute.String("url.path", req.URL.Path),
+				),
+			)
+			defer span.End()
+
+			c.SetRequest(req.WithContext(ctx))
+			err := next(c)
+			if err != nil {
+				span.RecordError(err)
+				// Фиксируем ответ, чтобы получить итоговый статус
+				c.Error(err)
+			}
+
+			status := c.Response().Status
+			if status == 0 {
+				status = http.StatusOK
+			}
+			finishSpan(span, route, status)
+
+			return err
+		}
+	}
+}
+`, config.ModuleName)
+	default:
+		content = fmt.Sprintf(`package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	"%s/pkg/tracing"
+)
+
+// TracingMiddleware создает серверный спан для каждого HTTP запроса
+func TracingMiddleware() gin.HandlerFunc {
+	tracer := otel.Tracer(tracing.TracerName)
+
+	return func(c *gin.Context) {
+		route := c.FullPath()
+		if route == "" {
+			route = c.Request.URL.Path
+		}
+
+		ctx := otel.GetTextMapPropagator().Extract(c.Request.Context(), propagation.HeaderCarrier(c.Request.Header))
+		ctx, span := tracer.Start(ctx, c.Request.Method+" "+route,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				attribute.String("http.request.method", c.Request.Method),
+				attribute.String("url.path", c.Request.URL.Path),
+			),
+		)
+		defer span.End()
+
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+
+		for _, err := range c.Errors {
+			span.RecordError(err.Err)
+		}
+		finishSpan(span, route, c.Writer.Status())
+	}
+}
+`, config.ModuleName)
+	}
+
+	content += `
+// finishSpan записывает маршрут и статус ответа, 5xx помечает спан ошибкой
+func finishSpan(span trace.Span, route string, status int) {
+	span.SetAttributes(
+		attribute.String("http.route", route),
+		attribute.Int("http.response.status_code", status),
+	)
+	if status >= 500 {
+		span.SetStatus(codes.Error, "")
+	}
+}
+`
+
+	tracingPath := filepath.Join(g.projectPath, "internal/middleware/tracing.go")
+	return os.WriteFile(tracingPath, []byte(content), 0644)
+}
@@ -0,0 +1,186 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// generateTransactions создает pkg/database/tx.go: транзакция в контексте и WithinTransaction.
+// Для CockroachDB добавляется повтор транзакций при ошибках сериализации
+func (g *Generator) generateTransactions(config *ProjectConfig) error {
+	files := map[string]string{
+		"pkg/database/tx.go": g.generateTxContext(),
+	}
+
+	if strings.ToLower(config.Database) == "cockroachdb" {
+		files["pkg/database/retry.go"] = g.generateTxRetry()
+		files["pkg/database/retry_test.go"] = g.generateTxRetryTest()
+	}
+
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(g.projectPath, name), []byte(content), 0644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// withinTransactionMethod генерирует метод WithinTransaction реализации Database name с получателем r
+func withinTransactionMethod(config *ProjectConfig, r, name string) string {
+	if strings.ToLower(config.Database) == "cockroachdb" {
+		return `// WithinTransaction выполняет fn в транзакции, репозитории берут ее из контекста fn.
+// Транзакция, отмененная CockroachDB из-за конфликта (SQLSTATE 40001), выполняется заново,
+// поэтому fn может быть вызвана несколько раз
+func (` + r + ` *` + name + `Database) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return withinRetryableTransaction(ctx, ` + r + `, fn)
+}`
+	}
+
+	return `// WithinTransaction выполняет fn в транзакции, репозитории берут ее из контекста fn
+func (` + r + ` *` + name + `Database) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return withinTransaction(ctx, ` + r + `, fn)
+}`
+}
+
+// generateTxContext генерирует общий для всех БД unit of work
+func (g *Generator) generateTxContext() string {
+	return `package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// txKey ключ транзакции в контексте
+type txKey struct{}
+
+// afterCommitKey ключ функций AfterCommit транзакции в контексте
+type afterCommitKey struct{}
+
+// afterCommitHooks функции, отложенные до подтверждения транзакции
+type afterCommitHooks struct {
+	mu    sync.Mutex
+	hooks []func()
+}
+
+// savepointKey ключ глубины вложенных WithinTransaction в контексте
+type savepointKey struct{}
+
+// savepointer транзакция с точками сохранения для вложенных WithinTransaction
+type savepointer interface {
+	Savepoint(name string) error
+	RollbackTo(name string) error
+}
+
+// contextWithTx добавляет транзакцию в контекст, BeginTx отдает его через Tx.Context()
+func contextWithTx(ctx context.Context, tx Tx) context.Context {
+	ctx = context.WithValue(ctx, afterCommitKey{}, &afterCommitHooks{})
+	return context.WithValue(ctx, txKey{}, tx)
+}
+
+// AfterCommit выполняет fn после подтверждения транзакции из ctx, вне транзакции - сразу.
+// При откате транзакции fn не выполняется
+func AfterCommit(ctx context.Context, fn func()) {
+	hooks, _ := ctx.Value(afterCommitKey{}).(*afterCommitHooks)
+	if hooks == nil {
+		fn()
+		return
+	}
+
+	hooks.mu.Lock()
+	hooks.hooks = append(hooks.hooks, fn)
+	hooks.mu.Unlock()
+}
+
+// runAfterCommit выполняет функции AfterCommit. Commit реализаций Tx вызывает ее после подтверждения
+func runAfterCommit(ctx context.Context) {
+	hooks, _ := ctx.Value(afterCommitKey{}).(*afterCommitHooks)
+	if hooks == nil {
+		return
+	}
+
+	hooks.mu.Lock()
+	fns := hooks.hooks
+	hooks.hooks = nil
+	hooks.mu.Unlock()
+
+	for _, fn := range fns {
+		fn()
+	}
+}
+
+// TxFromContext возвращает транзакцию из контекста или nil вне транзакции.
+// Репозитории выполняют запросы в ней, если она есть
+func TxFromContext(ctx context.Context) Tx {
+	tx, _ := ctx.Value(txKey{}).(Tx)
+	return tx
+}
+
+// withinTransaction выполняет fn в транзакции db и подтверждает ее, если fn вернула nil.
+// При ошибке или панике fn транзакция откатывается, паника пробрасывается дальше.
+// Если в ctx уже есть транзакция, fn выполняется в ней через withinSavepoint
+func withinTransaction(ctx context.Context, db Database, fn func(ctx context.Context) error) error {
+	if tx := TxFromContext(ctx); tx != nil {
+		return withinSavepoint(ctx, tx, fn)
+	}
+
+	tx, err := db.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	if err := fn(tx.Context()); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return errors.Join(err, rbErr)
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// withinSavepoint выполняет вложенный вызов в точке сохранения: ошибка fn откатывает только
+// ее изменения, внешняя транзакция продолжается. Транзакции без точек сохранения (MongoDB)
+// выполняют fn как есть, и откат происходит вместе с внешней транзакцией
+func withinSavepoint(ctx context.Context, tx Tx, fn func(ctx context.Context) error) error {
+	sp, ok := tx.(savepointer)
+	if !ok {
+		return fn(ctx)
+	}
+
+	depth, _ := ctx.Value(savepointKey{}).(int)
+	depth++
+	name := fmt.Sprintf("sp_%d", depth)
+	if err := sp.Savepoint(name); err != nil {
+		return err
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = sp.RollbackTo(name)
+			panic(p)
+		}
+	}()
+
+	if err := fn(context.WithValue(ctx, savepointKey{}, depth)); err != nil {
+		if rbErr := sp.RollbackTo(name); rbErr != nil {
+			return errors.Join(err, rbErr)
+		}
+		return err
+	}
+
+	return nil
+}
+`
+}
@@ -0,0 +1,638 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// configDatabaseType возвращает значение database.type для драйвера, выбранного при генерации
+func configDatabaseType(config *ProjectConfig) string {
+	switch strings.ToLower(config.Database) {
+	case "postgresql":
+		return "postgres"
+	case "mysql":
+		return "mysql"
+	case "mongodb":
+		return "mongodb"
+	case "cockroachdb":
+		return "cockroachdb"
+	case "clickhouse":
+		return "clickhouse"
+	case "in-memory", "sqlite":
+		return "sqlite"
+	default:
+		return ""
+	}
+}
+
+// generateConfigValidation создает internal/config/validate.go с проверкой всех секций конфигурации
+func (g *Generator) generateConfigValidation(config *ProjectConfig) error {
+	files := map[string]string{
+		"validate.go":      g.generateConfigValidate(config),
+		"validate_test.go": g.generateConfigValidateTest(config),
+	}
+
+	for name, content := range files {
+		path := filepath.Join(g.projectPath, "internal/config", name)
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// generateConfigValidate генерирует validate() для секций, которые есть в проекте
+func (g *Generator) generateConfigValidate(config *ProjectConfig) string {
+	content := `package config
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// ValidationError содержит все найденные ошибки конфигурации
+type ValidationError struct {
+	Problems []string
+}
+
+// Error выводит ошибки списком, по одной на строку
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("найдено ошибок: %d\n  - %s", len(e.Problems), strings.Join(e.Problems, "\n  - "))
+}
+
+// validator накапливает ошибки вместо остановки на первой
+type validator struct {
+	problems []string
+}
+
+func (v *validator) addf(field, format string, args ...interface{}) {
+	v.problems = append(v.problems, field+": "+fmt.Sprintf(format, args...))
+}
+
+func (v *validator) required(field, value string) {
+	if strings.TrimSpace(value) == "" {
+		v.addf(field, "обязательное поле")
+	}
+}
+
+func (v *validator) port(field string, port int) {
+	if port <= 0 || port > 65535 {
+		v.addf(field, "порт должен быть в диапазоне 1-65535, получено %d", port)
+	}
+}
+
+func (v *validator) nonNegative(field string, value int) {
+	if value < 0 {
+		v.addf(field, "значение не может быть отрицательным, получено %d", value)
+	}
+}
+
+// oneOf проверяет, что value входит в allowed. Пустая строка в allowed означает значение по умолчанию
+func (v *validator) oneOf(field, value string, allowed ...string) {
+	names := make([]string, 0, len(allowed))
+	for _, a := range allowed {
+		if value == a {
+			return
+		}
+		if a != "" {
+			names = append(names, a)
+		}
+	}
+	v.addf(field, "недопустимое значение %q, ожидается одно из: %s", value, strings.Join(names, ", "))
+}
+
+func (v *validator) url(field, value string, schemes ...string) {
+	u, err := url.Parse(value)
+	if err != nil || u.Host == "" {
+		v.addf(field, "некорректный URL %q", value)
+		return
+	}
+	v.oneOf(field+" (схема)", u.Scheme, schemes...)
+}
+
+func (v *validator) err() error {
+	if len(v.problems) == 0 {
+		return nil
+	}
+	return &ValidationError{Problems: v.problems}
+}
+
+// validate проверяет все секции конфигурации и возвращает *ValidationError со всеми ошибками
+func (c *Config) validate() error {
+	v := &validator{}
+
+	c.validateApp(v)`
+
+	if configDatabaseType(config) != "" {
+		content += `
+	c.validateDatabase(v)`
+	}
+
+	content += `
+	c.validateLogger(v)
+	c.validateMiddleware(v)
+	c.validateObservability(v)`
+
+	if config.EnableAuth {
+		content += `
+	c.validateAuth(v)`
+	}
+
+	if config.EnableOTel {
+		content += `
+	c.validateTracing(v)`
+	}
+
+	if hasCache(config) {
+		content += `
+	c.validateCache(v)`
+	}
+
+	content += `
+	c.validatePorts(v)
+
+	return v.err()
+}
+
+func (c *Config) validateApp(v *validator) {
+	v.required("app.name", c.App.Name)
+	v.port("app.port", c.App.Port)
+	validateTLS(v, "app.tls", c.App.TLS)
+	if _, err := c.App.TrustedProxyNets(); err != nil {
+		v.addf("app.trusted_proxies", "%v", err)
+	}
+	if c.HotReload.Enabled && c.HotReload.Interval <= 0 {
+		v.addf("hot_reload.interval", "значение должно быть больше 0, получено %d", c.HotReload.Interval)
+	}
+}
+
+func validateTLS(v *validator, field string, tls TLSConfig) {
+	v.oneOf(field+".min_version", tls.MinVersion, "", "1.2", "1.3")
+	if !tls.Enabled {
+		return
+	}
+	v.required(field+".cert_file", tls.CertFile)
+	v.required(field+".key_file", tls.KeyFile)
+}
+`
+
+	switch configDatabaseType(config) {
+	case "postgres", "mysql":
+		content += `
+// validateDatabase проверяет основную БД и datasources
+func (c *Config) validateDatabase(v *validator) {
+	validateDatabaseConfig(v, "database", c.Database)
+	for _, name := range sortedKeys(c.Datasources) {
+		validateDatabaseConfig(v, "datasources."+name, c.Datasources[name])
+	}
+}
+
+// validateDatabaseConfig проверяет, что настройки соответствуют драйверу, с которым собран сервис
+func validateDatabaseConfig(v *validator, field string, db DatabaseConfig) {
+	v.oneOf(field+".type", db.Type, "` + configDatabaseType(config) + `")
+	v.required(field+".host", db.Host)
+	v.port(field+".port", db.Port)
+	v.required(field+".user", db.User)
+	v.required(field+".name", db.Name)
+	validatePool(v, field, db)
+	validateReplicas(v, field, db.Replicas)
+}
+
+// validateReplicas проверяет реплики: имя обязательно и уникально, оно используется в health и метриках
+func validateReplicas(v *validator, field string, replicas []DatabaseReplicaConfig) {
+	names := make(map[string]bool, len(replicas))
+	for i, replica := range replicas {
+		path := fmt.Sprintf("%s.replicas[%d]", field, i)
+		v.required(path+".name", replica.Name)
+		v.required(path+".host", replica.Host)
+		v.port(path+".port", replica.Port)
+		if replica.Name != "" && names[replica.Name] {
+			v.addf(path+".name", "реплика %q уже объявлена", replica.Name)
+		}
+		names[replica.Name] = true
+	}
+}
+`
+	case "mongodb":
+		content += `
+// validateDatabase проверяет основную БД и datasources
+func (c *Config) validateDatabase(v *validator) {
+	validateDatabaseConfig(v, "database", c.Database)
+	for _, name := range sortedKeys(c.Datasources) {
+		validateDatabaseConfig(v, "datasources."+name, c.Datasources[name])
+	}
+}
+
+// validateDatabaseConfig проверяет, что настройки соответствуют драйверу, с которым собран сервис
+func validateDatabaseConfig(v *validator, field string, db DatabaseConfig) {
+	v.oneOf(field+".type", db.Type, "mongodb")
+	if db.URI == "" {
+		v.addf(field+".uri", "обязательное поле")
+	} else {
+		v.url(field+".uri", db.URI, "mongodb", "mongodb+srv")
+	}
+	v.required(field+".name", db.Name)
+	v.nonNegative(field+".timeout", db.Timeout)
+	v.oneOf(field+".read_preference", strings.ToLower(db.ReadPreference), "", "primary", "primarypreferred", "secondary", "secondarypreferred", "nearest")
+}
+`
+	case "cockroachdb", "clickhouse":
+		content += `
+// validateDatabase проверяет, что настройки соответствуют драйверу, с которым собран сервис
+func (c *Config) validateDatabase(v *validator) {
+	db := c.Database
+	v.oneOf("database.type", db.Type, "` + configDatabaseType(config) + `")
+	v.required("database.host", db.Host)
+	v.port("database.port", db.Port)
+	v.required("database.user", db.User)
+	v.required("database.name", db.Name)
+	v.nonNegative("database.timeout", db.Timeout)
+	validatePool(v, "database", db)
+}
+`
+	case "sqlite":
+		content += `
+// validateDatabase проверяет, что настройки соответствуют драйверу, с которым собран сервис
+func (c *Config) validateDatabase(v *validator) {
+	db := c.Database
+	v.oneOf("database.type", db.Type, "sqlite")
+	v.required("database.path", db.Path)
+	v.oneOf("database.journal_mode", strings.ToLower(db.JournalMode), "", "wal", "delete", "truncate", "persist", "memory", "off")
+	v.nonNegative("database.busy_timeout", db.BusyTimeout)
+	validatePool(v, "database", db)
+}
+`
+	}
+
+	if databaseType := configDatabaseType(config); databaseType != "" && databaseType != "mongodb" {
+		content += `
+// validatePool проверяет размеры пула соединений
+func validatePool(v *validator, field string, db DatabaseConfig) {
+	if db.MaxConnections <= 0 {
+		v.addf(field+".max_connections", "должно быть больше 0, получено %d", db.MaxConnections)
+	}
+	v.nonNegative(field+".max_idle_connections", db.MaxIdleConnections)
+	if db.MaxConnections > 0 && db.MaxIdleConnections > db.MaxConnections {
+		v.addf(field+".max_idle_connections", "не может превышать max_connections (%d > %d)", db.MaxIdleConnections, db.MaxConnections)
+	}
+}
+`
+	}
+
+	content += `
+var (
+	logLevels  = []string{"", "debug", "info", "warn", "warning", "error", "fatal"}
+	logFormats = []string{"", "json", "text"}
+	logOutputs = []string{"", "stdout", "stderr", "file"}
+)
+
+func (c *Config) validateLogger(v *validator) {
+	l := c.Logger
+	v.oneOf("logger.level", l.Level, logLevels...)
+	v.oneOf("logger.format", l.Format, logFormats...)
+	v.oneOf("logger.output", l.Output, logOutputs...)
+	validateLogFile(v, "logger.file", l.File)
+
+	for i, sink := range l.Sinks {
+		field := fmt.Sprintf("logger.sinks[%d]", i)
+		v.oneOf(field+".output", sink.Output, logOutputs...)
+		v.oneOf(field+".format", sink.Format, logFormats...)
+		v.oneOf(field+".level", sink.Level, logLevels...)
+		validateLogFile(v, field+".file", sink.File)
+	}
+
+	for _, name := range sortedKeys(l.Packages) {
+		v.oneOf("logger.packages."+name, l.Packages[name], logLevels[1:]...)
+	}
+}
+
+func validateLogFile(v *validator, field string, file LoggerFileConfig) {
+	v.nonNegative(field+".max_size_mb", file.MaxSizeMB)
+	v.nonNegative(field+".max_age_days", file.MaxAgeDays)
+	v.nonNegative(field+".max_backups", file.MaxBackups)
+}
+
+func (c *Config) validateMiddleware(v *validator) {
+	cors := c.Middleware.CORS
+	if cors.Enabled && cors.AllowCredentials {
+		for _, origin := range cors.AllowOrigins {
+			if origin == "*" {
+				v.addf("middleware.cors.allow_origins", "\"*\" нельзя использовать вместе с allow_credentials")
+			}
+		}
+	}
+	v.nonNegative("middleware.cors.max_age", cors.MaxAge)
+
+	rl := c.Middleware.RateLimit
+	if !rl.Enabled {
+		return
+	}
+	v.oneOf("middleware.rate_limit.backend", rl.Backend, "", "memory", "redis")
+	v.oneOf("middleware.rate_limit.key_by", rl.KeyBy, "", "ip", "api_key", "user")
+	if rl.KeyBy == "api_key" {
+		v.required("middleware.rate_limit.api_key_header", rl.APIKeyHeader)
+	}
+	if rl.Backend == "redis" {
+		v.required("middleware.rate_limit.redis.addr", rl.Redis.Addr)
+	}
+	validateRateLimitRule(v, "middleware.rate_limit.default", rl.Default)
+	for _, group := range sortedKeys(rl.Groups) {
+		validateRateLimitRule(v, "middleware.rate_limit.groups."+group, rl.Groups[group])
+	}
+}
+
+func validateRateLimitRule(v *validator, field string, rule RateLimitRule) {
+	if rule.Requests <= 0 {
+		v.addf(field+".requests", "должно быть больше 0, получено %d", rule.Requests)
+	}
+	if rule.Window <= 0 {
+		v.addf(field+".window", "должно быть больше 0, получено %d", rule.Window)
+	}
+	v.nonNegative(field+".burst", rule.Burst)
+}
+
+// validateObservability проверяет метрики, проверки состояния и admin сервер
+func (c *Config) validateObservability(v *validator) {
+	if c.Metrics.Enabled {
+		if !strings.HasPrefix(c.Metrics.Path, "/") {
+			v.addf("metrics.path", "путь должен начинаться с /, получено %q", c.Metrics.Path)
+		}
+		if c.Metrics.Port != 0 {
+			v.port("metrics.port", c.Metrics.Port)
+		}
+	}
+
+	h := c.Health
+	v.nonNegative("health.timeout", h.Timeout)
+	v.nonNegative("health.cache_ttl", h.CacheTTL)
+	if h.Disk.Enabled {
+		v.required("health.disk.path", h.Disk.Path)
+		v.nonNegative("health.disk.min_free_mb", h.Disk.MinFreeMB)
+	}
+	for i, check := range h.HTTP {
+		field := fmt.Sprintf("health.http[%d]", i)
+		v.required(field+".name", check.Name)
+		v.url(field+".url", check.URL, "http", "https")
+	}
+
+	if c.Admin.Enabled {
+		v.port("admin.port", c.Admin.Port)
+		// Без токена pprof и /config доступны всем, кто достучится до порта
+		if c.Admin.Token == "" && !c.Admin.Loopback() && !c.App.Debug {
+			v.addf("admin.token", "обязателен, если admin.host не loopback адрес и app.debug выключен")
+		}
+	}
+}
+`
+
+	if config.EnableAuth {
+		content += `
+func (c *Config) validateAuth(v *validator) {
+	a := c.Auth
+	if !a.Enabled {
+		return
+	}
+	if a.Secret == "" && a.JWKSURL == "" {
+		v.addf("auth", "нужно задать secret или jwks_url")
+	}
+	if a.JWKSURL != "" {
+		v.url("auth.jwks_url", a.JWKSURL, "http", "https")
+	}
+	v.nonNegative("auth.jwks_cache_ttl", a.JWKSCacheTTL)
+	v.nonNegative("auth.leeway", a.Leeway)
+}
+`
+	}
+
+	if config.EnableOTel {
+		content += `
+func (c *Config) validateTracing(v *validator) {
+	t := c.Tracing
+	if !t.Enabled {
+		return
+	}
+	v.oneOf("tracing.exporter", t.Exporter, "", "otlp", "stdout", "none")
+	if t.Exporter == "" || t.Exporter == "otlp" {
+		v.required("tracing.endpoint", t.Endpoint)
+	}
+	if t.SampleRatio < 0 || t.SampleRatio > 1 {
+		v.addf("tracing.sample_ratio", "должно быть в диапазоне 0.0-1.0, получено %g", t.SampleRatio)
+	}
+}
+`
+	}
+
+	if hasCache(config) {
+		content += `
+func (c *Config) validateCache(v *validator) {
+	cache := c.Cache
+	v.oneOf("cache.backend", cache.Backend, "", "memory", "redis")
+	if cache.Backend == "redis" {
+		v.required("cache.redis.addr", cache.Redis.Addr)
+	}
+	v.nonNegative("cache.ttl", cache.TTL)
+}
+`
+	}
+
+	content += `
+// validatePorts проверяет, что включенные серверы слушают разные порты
+func (c *Config) validatePorts(v *validator) {
+	ports := map[int][]string{}
+	listen := func(field string, port int) {
+		if port > 0 {
+			ports[port] = append(ports[port], field)
+		}
+	}
+
+	listen("app.port", c.App.Port)`
+
+	if config.EnableGRPC {
+		content += `
+	if c.GRPC.Enabled {
+		v.port("grpc.port", c.GRPC.Port)
+		v.nonNegative("grpc.max_connection_age", c.GRPC.MaxConnectionAge)
+		v.nonNegative("grpc.max_connection_idle", c.GRPC.MaxConnectionIdle)
+		validateTLS(v, "grpc.tls", c.GRPC.TLS)
+		listen("grpc.port", c.GRPC.Port)
+	}`
+	}
+
+	content += `
+	if c.Metrics.Enabled {
+		listen("metrics.port", c.Metrics.Port)
+	}
+	if c.Admin.Enabled {
+		listen("admin.port", c.Admin.Port)
+	}
+
+	for _, port := range sortedKeys(ports) {
+		if fields := ports[port]; len(fields) > 1 {
+			v.addf(strings.Join(fields, ", "), "порт %d используется несколькими серверами", port)
+		}
+	}
+}
+
+// sortedKeys возвращает ключи map по порядку, чтобы ошибки выводились стабильно
+func sortedKeys[K int | string, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
+`
+
+	return content
+}
+
+// generateConfigValidateTest генерирует тесты проверки конфигурации
+func (g *Generator) generateConfigValidateTest(config *ProjectConfig) string {
+	content := `package config
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// validConfig минимальная корректная конфигурация
+func validConfig() *Config {
+	cfg := &Config{}
+	cfg.App.Name = "test"
+	cfg.App.Port = 8080`
+
+	switch configDatabaseType(config) {
+	case "postgres", "mysql", "cockroachdb", "clickhouse":
+		content += `
+	cfg.Database = DatabaseConfig{Type: "` + configDatabaseType(config) + `", Host: "localhost", Port: 5432, User: "app", Name: "app", MaxConnections: 10}`
+	case "mongodb":
+		content += `
+	cfg.Database = DatabaseConfig{Type: "mongodb", URI: "mongodb://localhost:27017", Name: "app"}`
+	case "sqlite":
+		content += `
+	cfg.Database = DatabaseConfig{Type: "sqlite", Path: ":memory:", MaxConnections: 1}`
+	}
+
+	content += `
+	return cfg
+}
+
+func TestValidateAcceptsValidConfig(t *testing.T) {
+	if err := validConfig().validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestValidateAdminToken(t *testing.T) {
+	tests := []struct {
+		name  string
+		admin AdminConfig
+		debug bool
+		valid bool
+	}{
+		{name: "token", admin: AdminConfig{Token: "secret"}, valid: true},
+		{name: "all interfaces without token", admin: AdminConfig{}},
+		{name: "public host without token", admin: AdminConfig{Host: "0.0.0.0"}},
+		{name: "loopback without token", admin: AdminConfig{Host: "127.0.0.1"}, valid: true},
+		{name: "localhost without token", admin: AdminConfig{Host: "localhost"}, valid: true},
+		{name: "debug without token", admin: AdminConfig{}, debug: true, valid: true},
+	}
+
+	for _, tt := range tests {
+		cfg := validConfig()
+		cfg.App.Debug = tt.debug
+		cfg.Admin = tt.admin
+		cfg.Admin.Enabled = true
+		cfg.Admin.Port = 9091
+
+		err := cfg.validate()
+		if tt.valid && err != nil {
+			t.Errorf("%s: unexpected error: %v", tt.name, err)
+		}
+		if !tt.valid && (err == nil || !strings.Contains(err.Error(), "admin.token")) {
+			t.Errorf("%s: expected admin.token error, got %v", tt.name, err)
+		}
+	}
+}
+
+func TestValidateAggregatesErrors(t *testing.T) {
+	cfg := validConfig()
+	cfg.App.Name = ""
+	cfg.Logger.Level = "verbose"
+	cfg.Admin.Enabled = true
+	cfg.Admin.Port = cfg.App.Port
+	cfg.App.TrustedProxies = []string{"10.0.0.0/8", "proxy.local"}
+
+	err := cfg.validate()
+
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("expected *ValidationError, got %v", err)
+	}
+
+	expected := []string{"app.name", "logger.level", "app.trusted_proxies", "admin.token", "app.port, admin.port"}
+	for _, field := range expected {
+		if !strings.Contains(err.Error(), field) {
+			t.Errorf("expected problem for %s in:\n%v", field, err)
+		}
+	}
+	if len(validationErr.Problems) != len(expected) {
+		t.Errorf("expected %d problems, got %d:\n%v", len(expected), len(validationErr.Problems), err)
+	}
+}
+`
+
+	if configDatabaseType(config) != "" {
+		content += `
+func TestValidateDatabaseType(t *testing.T) {
+	cfg := validConfig()
+	cfg.Database.Type = "oracle"
+
+	if err := cfg.validate(); err == nil || !strings.Contains(err.Error(), "database.type") {
+		t.Errorf("expected database.type error, got %v", err)
+	}
+}
+`
+	}
+
+	if supportsReplicas(config) {
+		content += `
+func TestValidateDatabaseReplicas(t *testing.T) {
+	cfg := validConfig()
+	cfg.Database.Replicas = []DatabaseReplicaConfig{
+		{Name: "replica-1", Host: "replica-1", Port: 5432},
+		{Name: "replica-1", Host: "replica-2", Port: 5432},
+	}
+	cfg.Datasources = map[string]DatabaseConfig{"analytics": {Type: cfg.Database.Type}}
+
+	err := cfg.validate()
+	for _, field := range []string{"database.replicas[1].name", "datasources.analytics.host", "datasources.analytics.max_connections"} {
+		if err == nil || !strings.Contains(err.Error(), field) {
+			t.Errorf("expected %s error, got %v", field, err)
+		}
+	}
+}
+`
+	}
+
+	if hasCache(config) {
+		content += `
+func TestValidateCacheRedisAddr(t *testing.T) {
+	cfg := validConfig()
+	cfg.Cache.Backend = "redis"
+
+	if err := cfg.validate(); err == nil || !strings.Contains(err.Error(), "cache.redis.addr") {
+		t.Errorf("expected cache.redis.addr error, got %v", err)
+	}
+}
+`
+	}
+
+	return content
+}